@@ -0,0 +1,2 @@
+data "prowlarr_health" "example" {
+}
@@ -0,0 +1,5 @@
+data "prowlarr_indexer_stats" "example" {
+  start_date  = "2024-01-01T00:00:00Z"
+  end_date    = "2024-01-31T23:59:59Z"
+  indexer_ids = [1, 2]
+}
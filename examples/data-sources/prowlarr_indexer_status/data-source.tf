@@ -0,0 +1,3 @@
+data "prowlarr_indexer_status" "example" {
+  indexer_id = 1
+}
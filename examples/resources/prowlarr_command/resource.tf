@@ -0,0 +1,7 @@
+resource "prowlarr_command" "example" {
+  name = "ApplicationIndexerSync"
+
+  triggers = {
+    application = prowlarr_application_sonarr.example.id
+  }
+}
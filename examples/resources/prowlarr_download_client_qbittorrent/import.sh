@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import prowlarr_download_client_qbittorrent.example 1
\ No newline at end of file
+terraform import prowlarr_download_client_qbittorrent.example 1
+
+# import using the name
+terraform import prowlarr_download_client_qbittorrent.example name:Example
\ No newline at end of file
@@ -1,2 +1,8 @@
 # import using the API/UI ID
-terraform import prowlarr_indexer.example 1
\ No newline at end of file
+terraform import prowlarr_indexer.example 1
+
+# import using the name
+terraform import prowlarr_indexer.example name:Example
+
+# import using the implementation and definition name
+terraform import prowlarr_indexer.example definition:Cardigann/1337x
\ No newline at end of file
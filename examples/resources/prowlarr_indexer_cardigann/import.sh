@@ -0,0 +1,8 @@
+# import using the API/UI ID
+terraform import prowlarr_indexer_cardigann.example 1
+
+# import using the name
+terraform import prowlarr_indexer_cardigann.example name:Example
+
+# import using the implementation and definition name
+terraform import prowlarr_indexer_cardigann.example definition:Cardigann/1337x
\ No newline at end of file
@@ -0,0 +1,21 @@
+resource "prowlarr_indexer_cardigann" "example" {
+  enable          = true
+  name            = "IPTorrents"
+  definition_name = "iptorrents"
+  app_profile_id  = 1
+  tags            = [1]
+
+  base_url    = "https://iptorrents.com/"
+  query_limit = 100
+  limits_unit = 0
+  seed_ratio  = 1.5
+
+  settings = {
+    freeleech = "false"
+    sort      = "0"
+  }
+
+  sensitive_settings = {
+    cookie = "uid=123; pass=abc"
+  }
+}
@@ -0,0 +1,11 @@
+resource "prowlarr_indexer_newznab" "example" {
+  enable         = true
+  name           = "NZBgeek"
+  app_profile_id = 1
+  priority       = 25
+  base_url       = "https://api.nzbgeek.info"
+  api_path       = "/api"
+  api_key        = "APIKey"
+  query_limit    = 100
+  limits_unit    = 0
+}
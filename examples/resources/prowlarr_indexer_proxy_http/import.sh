@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import prowlarr_indexer_proxy_http.example 1
\ No newline at end of file
+terraform import prowlarr_indexer_proxy_http.example 1
+
+# import using the name
+terraform import prowlarr_indexer_proxy_http.example name:Example
\ No newline at end of file
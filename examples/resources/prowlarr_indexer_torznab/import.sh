@@ -0,0 +1,5 @@
+# import using the API/UI ID
+terraform import prowlarr_indexer_torznab.example 1
+
+# import using the name
+terraform import prowlarr_indexer_torznab.example name:Example
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "prowlarr_indexer_torznab" "example" {
+  enable            = true
+  name              = "Torznab"
+  app_profile_id    = 1
+  base_url          = "https://torznab.example.com"
+  api_path          = "/api"
+  api_key           = "APIKey"
+  seed_ratio        = 1.5
+  seed_time         = 1440
+  prefer_magnet_url = true
+}
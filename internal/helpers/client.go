@@ -0,0 +1,308 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var errInvalidCACert = errors.New("no valid certificate found in CA PEM")
+
+// ErrReadOnly is returned for mutating requests when the client is read only.
+var ErrReadOnly = errors.New("mutating request rejected in read-only mode")
+
+// ClientOptions contains the tuning options for the HTTP client used by the SDK.
+type ClientOptions struct {
+	// TLSConfig is used for HTTPS connections, if set.
+	TLSConfig *tls.Config
+	// Proxy is the HTTP proxy URL, if not set the proxy environment variables are used.
+	Proxy *url.URL
+	// Username and Password are used for basic authentication, if set.
+	Username       string
+	Password       string
+	RetryWaitMin   time.Duration
+	RetryWaitMax   time.Duration
+	RequestTimeout time.Duration
+	MaxRetries     int
+	// MaxParallelRequests caps the number of concurrent requests, 0 means unlimited.
+	MaxParallelRequests int
+	// ReadOnly rejects all requests but GET, HEAD and OPTIONS ones.
+	ReadOnly bool
+	// SerializeWrites sends mutating requests one at a time.
+	SerializeWrites bool
+}
+
+// TLSOptions contains the PEM encoded certificates used to build the TLS configuration.
+type TLSOptions struct {
+	CACert             []byte
+	ClientCert         []byte
+	ClientKey          []byte
+	InsecureSkipVerify bool
+}
+
+// NewTLSConfig returns the TLS configuration with the given CA and client certificates.
+func NewTLSConfig(options TLSOptions) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if len(options.CACert) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(options.CACert) {
+			return nil, errInvalidCACert
+		}
+
+		config.RootCAs = pool
+	}
+
+	if len(options.ClientCert) > 0 || len(options.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(options.ClientCert, options.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// basicAuthTransport adds basic authentication to all requests.
+type basicAuthTransport struct {
+	transport http.RoundTripper
+	username  string
+	password  string
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.SetBasicAuth(t.username, t.password)
+
+	return t.transport.RoundTrip(req)
+}
+
+// readOnlyTransport rejects mutating requests before they are sent.
+type readOnlyTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isSafeMethod(req.Method) {
+		return t.transport.RoundTrip(req)
+	}
+
+	closeRequestBody(req)
+
+	return nil, fmt.Errorf("%w: %s %s", ErrReadOnly, req.Method, req.URL.Path)
+}
+
+// limitTransport caps the number of concurrent requests and optionally serializes the mutating ones.
+// Slots are held across retries, so that a retried write is not interleaved with other writes.
+type limitTransport struct {
+	transport http.RoundTripper
+	requests  chan struct{}
+	writes    chan struct{}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Writes are queued before taking a request slot, so that waiting writes do not block reads.
+	if t.writes != nil && !isSafeMethod(req.Method) {
+		if err := acquire(req.Context(), t.writes); err != nil {
+			closeRequestBody(req)
+
+			return nil, err
+		}
+		defer release(t.writes)
+	}
+
+	if t.requests != nil {
+		if err := acquire(req.Context(), t.requests); err != nil {
+			closeRequestBody(req)
+
+			return nil, err
+		}
+		defer release(t.requests)
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+func acquire(ctx context.Context, semaphore chan struct{}) error {
+	select {
+	case semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func release(semaphore chan struct{}) {
+	<-semaphore
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	return isSafeMethod(method) || method == http.MethodPut || method == http.MethodDelete
+}
+
+type requestSentKey struct{}
+
+// sentTransport tracks whether non idempotent requests were written to the connection,
+// so that connection errors happening afterwards are not retried.
+type sentTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *sentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isIdempotentMethod(req.Method) {
+		return t.transport.RoundTrip(req)
+	}
+
+	sent := &atomic.Bool{}
+	ctx := context.WithValue(req.Context(), requestSentKey{}, sent)
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err == nil {
+				sent.Store(true)
+			}
+		},
+	})
+
+	return t.transport.RoundTrip(req.WithContext(ctx))
+}
+
+// requestSent reports whether the last attempt of a non idempotent request was written, resetting it for the next one.
+func requestSent(ctx context.Context) bool {
+	sent, ok := ctx.Value(requestSentKey{}).(*atomic.Bool)
+
+	return ok && sent.Swap(false)
+}
+
+// retryPolicy extends the default one, retrying also SQLite lock errors whatever the status code.
+// Non idempotent requests (POST, PATCH) may have been committed despite a server error, so they are retried
+// only on 429, SQLite lock errors and connection errors happening before the request is sent, to avoid creating duplicates.
+func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	sent := requestSent(ctx)
+
+	if err != nil && sent {
+		return false, nil
+	}
+
+	if err == nil && resp.StatusCode >= http.StatusBadRequest && isDatabaseLocked(resp) {
+		return true, nil
+	}
+
+	if err == nil && resp.Request != nil && !isIdempotentMethod(resp.Request.Method) && resp.StatusCode != http.StatusTooManyRequests {
+		return false, nil
+	}
+
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
+
+// isDatabaseLocked checks if the response body contains a SQLite lock error.
+// The body is restored, so that it can still be parsed by the SDK.
+func isDatabaseLocked(resp *http.Response) bool {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return err == nil && bytes.Contains(bytes.ToLower(body), []byte("database is locked"))
+}
+
+// NewHTTPClient returns an HTTP client retrying with backoff on connection errors, 429, 5xx and database lock responses,
+// with the non idempotent requests retried only when they were surely not processed.
+// The given context is only used for logging.
+func NewHTTPClient(ctx context.Context, options ClientOptions) *http.Client {
+	client := retryablehttp.NewClient()
+	client.HTTPClient.Timeout = options.RequestTimeout
+
+	if transport, ok := client.HTTPClient.Transport.(*http.Transport); ok {
+		if options.TLSConfig != nil {
+			transport.TLSClientConfig = options.TLSConfig
+		}
+
+		if options.Proxy != nil {
+			transport.Proxy = http.ProxyURL(options.Proxy)
+		}
+	}
+
+	if options.Username != "" || options.Password != "" {
+		client.HTTPClient.Transport = &basicAuthTransport{
+			transport: client.HTTPClient.Transport,
+			username:  options.Username,
+			password:  options.Password,
+		}
+	}
+
+	client.RetryMax = options.MaxRetries
+	client.RetryWaitMin = options.RetryWaitMin
+	client.RetryWaitMax = options.RetryWaitMax
+	client.CheckRetry = retryPolicy
+	// Return the last response as is, so that the SDK can parse its body.
+	client.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	// Disable the default logger, retries are logged through tflog.
+	client.Logger = nil
+	client.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+		if attempt > 0 {
+			tflog.Warn(ctx, "retrying request", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.Redacted(),
+				"attempt": attempt,
+			})
+		}
+	}
+
+	httpClient := client.StandardClient()
+	httpClient.Transport = &sentTransport{transport: httpClient.Transport}
+
+	if options.MaxParallelRequests > 0 || options.SerializeWrites {
+		limit := &limitTransport{transport: httpClient.Transport}
+
+		if options.MaxParallelRequests > 0 {
+			limit.requests = make(chan struct{}, options.MaxParallelRequests)
+		}
+
+		if options.SerializeWrites {
+			limit.writes = make(chan struct{}, 1)
+		}
+
+		httpClient.Transport = limit
+	}
+
+	// Mutating requests are rejected outside of the retryable client, so that they are never retried.
+	if options.ReadOnly {
+		httpClient.Transport = &readOnlyTransport{transport: httpClient.Transport}
+	}
+
+	return httpClient
+}
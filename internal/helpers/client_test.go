@@ -0,0 +1,404 @@
+package helpers
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method     string
+		status     int
+		maxRetries int
+		expected   int32
+	}{
+		"service unavailable": {
+			method:     http.MethodGet,
+			status:     http.StatusServiceUnavailable,
+			maxRetries: 2,
+			expected:   3,
+		},
+		"too many requests": {
+			method:     http.MethodGet,
+			status:     http.StatusTooManyRequests,
+			maxRetries: 1,
+			expected:   2,
+		},
+		"not found": {
+			method:     http.MethodGet,
+			status:     http.StatusNotFound,
+			maxRetries: 2,
+			expected:   1,
+		},
+		"put service unavailable": {
+			method:     http.MethodPut,
+			status:     http.StatusServiceUnavailable,
+			maxRetries: 2,
+			expected:   3,
+		},
+		"post internal server error": {
+			method:     http.MethodPost,
+			status:     http.StatusInternalServerError,
+			maxRetries: 2,
+			expected:   1,
+		},
+		"post too many requests": {
+			method:     http.MethodPost,
+			status:     http.StatusTooManyRequests,
+			maxRetries: 1,
+			expected:   2,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			client := NewHTTPClient(context.Background(), ClientOptions{
+				MaxRetries:     test.maxRetries,
+				RetryWaitMin:   time.Millisecond,
+				RetryWaitMax:   time.Millisecond,
+				RequestTimeout: time.Second,
+			})
+
+			req, err := http.NewRequestWithContext(context.Background(), test.method, server.URL, strings.NewReader("{}"))
+			assert.NoError(t, err)
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.expected, calls.Load())
+		})
+	}
+}
+
+func TestNewHTTPClientReadOnly(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewHTTPClient(context.Background(), ClientOptions{
+		MaxRetries:     2,
+		RetryWaitMin:   time.Millisecond,
+		RetryWaitMax:   time.Millisecond,
+		RequestTimeout: time.Second,
+		ReadOnly:       true,
+	})
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestNewHTTPClientDatabaseLocked(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"database is locked"}`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewHTTPClient(context.Background(), ClientOptions{
+		MaxRetries:     2,
+		RetryWaitMin:   time.Millisecond,
+		RetryWaitMax:   time.Millisecond,
+		RequestTimeout: time.Second,
+	})
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestNewHTTPClientConnectionError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		expected int32
+	}{
+		"put": {
+			method:   http.MethodPut,
+			expected: 3,
+		},
+		"post": {
+			method:   http.MethodPost,
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			// The request is received, but the connection is closed before answering.
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+
+				conn, _, err := w.(http.Hijacker).Hijack()
+				assert.NoError(t, err)
+				conn.Close()
+			}))
+			t.Cleanup(server.Close)
+
+			client := NewHTTPClient(context.Background(), ClientOptions{
+				MaxRetries:     2,
+				RetryWaitMin:   time.Millisecond,
+				RetryWaitMax:   time.Millisecond,
+				RequestTimeout: time.Second,
+			})
+
+			req, err := http.NewRequestWithContext(context.Background(), test.method, server.URL, strings.NewReader("{}"))
+			assert.NoError(t, err)
+
+			resp, err := client.Do(req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			assert.Error(t, err)
+			assert.Equal(t, test.expected, calls.Load())
+		})
+	}
+}
+
+func TestRetryPolicyNotSent(t *testing.T) {
+	t.Parallel()
+
+	sent := &atomic.Bool{}
+	ctx := context.WithValue(context.Background(), requestSentKey{}, sent)
+
+	// Connection refused before writing the request.
+	retry, err := retryPolicy(ctx, nil, errors.New("dial tcp: connection refused"))
+	assert.NoError(t, err)
+	assert.True(t, retry)
+
+	sent.Store(true)
+
+	retry, err = retryPolicy(ctx, nil, errors.New("EOF"))
+	assert.NoError(t, err)
+	assert.False(t, retry)
+}
+
+func TestNewHTTPClientLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		options ClientOptions
+		method  string
+		limit   int32
+	}{
+		"max parallel requests": {
+			options: ClientOptions{MaxParallelRequests: 2},
+			method:  http.MethodGet,
+			limit:   2,
+		},
+		"serialize writes": {
+			options: ClientOptions{SerializeWrites: true},
+			method:  http.MethodPost,
+			limit:   1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				current, peak atomic.Int32
+				once          sync.Once
+			)
+
+			// Requests are held until the limit is reached, to show that it is actually used.
+			reached := make(chan struct{})
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				value := current.Add(1)
+				defer current.Add(-1)
+
+				for {
+					old := peak.Load()
+					if value <= old || peak.CompareAndSwap(old, value) {
+						break
+					}
+				}
+
+				if value == test.limit {
+					once.Do(func() { close(reached) })
+				}
+
+				select {
+				case <-reached:
+				case <-time.After(2 * time.Second):
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(server.Close)
+
+			test.options.RequestTimeout = 5 * time.Second
+			client := NewHTTPClient(context.Background(), test.options)
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < 6; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					req, err := http.NewRequestWithContext(context.Background(), test.method, server.URL, nil)
+					assert.NoError(t, err)
+
+					resp, err := client.Do(req)
+					assert.NoError(t, err)
+					resp.Body.Close()
+				}()
+			}
+
+			wg.Wait()
+			assert.LessOrEqual(t, peak.Load(), test.limit)
+
+			select {
+			case <-reached:
+			default:
+				t.Errorf("limit %d never reached", test.limit)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientTLS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := map[string]struct {
+		tls      TLSOptions
+		username string
+		status   int
+		fail     bool
+	}{
+		"unknown authority": {
+			tls:  TLSOptions{},
+			fail: true,
+		},
+		"custom CA": {
+			tls:      TLSOptions{CACert: caCert},
+			username: "user",
+			status:   http.StatusOK,
+		},
+		"insecure": {
+			tls:      TLSOptions{InsecureSkipVerify: true},
+			username: "user",
+			status:   http.StatusOK,
+		},
+		"wrong credentials": {
+			tls:      TLSOptions{CACert: caCert},
+			username: "other",
+			status:   http.StatusUnauthorized,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tlsConfig, err := NewTLSConfig(test.tls)
+			assert.NoError(t, err)
+
+			client := NewHTTPClient(context.Background(), ClientOptions{
+				TLSConfig:      tlsConfig,
+				Username:       test.username,
+				Password:       "pass",
+				RequestTimeout: time.Second,
+			})
+
+			resp, err := client.Get(server.URL)
+			if test.fail {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, test.status, resp.StatusCode)
+		})
+	}
+}
+
+func TestNewTLSConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTLSConfig(TLSOptions{CACert: []byte("invalid")})
+	assert.ErrorIs(t, err, errInvalidCACert)
+
+	_, err = NewTLSConfig(TLSOptions{ClientCert: []byte("invalid")})
+	assert.Error(t, err)
+}
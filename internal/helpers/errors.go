@@ -1,9 +1,20 @@
 package helpers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // define constant for error management.
@@ -12,23 +23,182 @@ const (
 	Read                              = "read"
 	Update                            = "update"
 	Delete                            = "delete"
+	Validate                          = "validate"
 	List                              = "list"
 	ClientError                       = "Client Error"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
+	MaskedValueWarning                = "Masked Sensitive Value"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
+	ValidationError                   = "Validation Error"
+	ValidationWarning                 = "Validation Warning"
 )
 
+// ValidationFailure is an entry of the validation errors returned by Prowlarr.
+type ValidationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	IsWarning    bool   `json:"isWarning"`
+}
+
+// SchemaPaths is implemented by tfsdk.State and tfsdk.Plan, it is used to check that an attribute exists.
+type SchemaPaths interface {
+	PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics)
+}
+
 func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
 
 func ParseClientError(action, name string, err error) string {
+	if errors.Is(err, ErrReadOnly) {
+		return fmt.Sprintf("Unable to %s %s, the provider is configured with `read_only = true` and no change can be applied.\nDetails:\n%s", action, name, err)
+	}
+
 	if e, ok := err.(*prowlarr.GenericOpenAPIError); ok {
 		return fmt.Sprintf("Unable to %s %s, got error: %s\nDetails:\n%s", action, name, err, string(e.Body()))
 	}
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// ParseValidationFailures returns the validation failures contained in the API error body, if any.
+func ParseValidationFailures(err error) []ValidationFailure {
+	var (
+		apiErr   *prowlarr.GenericOpenAPIError
+		failures []ValidationFailure
+	)
+
+	if !errors.As(err, &apiErr) || json.Unmarshal(apiErr.Body(), &failures) != nil {
+		return nil
+	}
+
+	// Discard arrays of unrelated objects.
+	for _, failure := range failures {
+		if failure.ErrorMessage == "" {
+			return nil
+		}
+	}
+
+	return failures
+}
+
+// AddClientError adds the diagnostics of a failed API call.
+// Prowlarr validation failures get a diagnostic each, attached to the matching attribute if any,
+// and warnings are reported as such. Other errors are reported as a single client error.
+// Since the call failed, a client error is also added when Prowlarr only returns warnings.
+func AddClientError(ctx context.Context, diags *diag.Diagnostics, schema SchemaPaths, action, name string, err error) {
+	failures := ParseValidationFailures(err)
+	warningsOnly := true
+
+	for _, failure := range failures {
+		warningsOnly = warningsOnly && failure.IsWarning
+		attrPath, property := validationFailurePath(ctx, schema, failure.PropertyName)
+		summary, detail := ValidationError, fmt.Sprintf("Unable to %s %s: %s", action, name, failure.ErrorMessage)
+
+		if failure.IsWarning {
+			summary, detail = ValidationWarning, fmt.Sprintf("Prowlarr warning on %s %s: %s", action, name, failure.ErrorMessage)
+		}
+
+		if property != "" {
+			detail = fmt.Sprintf("%s\nProperty: %s", detail, property)
+		}
+
+		switch {
+		case failure.IsWarning && attrPath != nil:
+			diags.AddAttributeWarning(*attrPath, summary, detail)
+		case failure.IsWarning:
+			diags.AddWarning(summary, detail)
+		case attrPath != nil:
+			diags.AddAttributeError(*attrPath, summary, detail)
+		default:
+			diags.AddError(summary, detail)
+		}
+	}
+
+	if warningsOnly {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+	}
+}
+
+// validationFailurePath maps a Prowlarr property name (e.g. `BaseUrl`) to the matching attribute (`base_url`).
+// Properties without a dedicated attribute are attached to `fields`, when present, and described as `fields[name=baseUrl]`.
+func validationFailurePath(ctx context.Context, schema SchemaPaths, property string) (*path.Path, string) {
+	// Only the last segment of nested properties (e.g. `Settings.BaseUrl`) is relevant.
+	if index := strings.LastIndex(property, "."); index >= 0 {
+		property = property[index+1:]
+	}
+
+	if property == "" || schema == nil {
+		return nil, property
+	}
+
+	for _, attrPath := range []path.Path{path.Root(toSnakeCase(property)), path.Root("fields")} {
+		if _, diags := schema.PathMatches(ctx, attrPath.Expression()); !diags.HasError() {
+			if attrPath.Equal(path.Root("fields")) {
+				return &attrPath, fmt.Sprintf("fields[name=%s]", toLowerCamelCase(property))
+			}
+
+			return &attrPath, property
+		}
+	}
+
+	return nil, property
+}
+
+// toSnakeCase converts a Pascal or camel case name to snake case, keeping acronyms together (e.g. `URLBase` to `url_base`).
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+
+	var builder strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			builder.WriteRune('_')
+		}
+
+		builder.WriteRune(unicode.ToLower(r))
+	}
+
+	return builder.String()
+}
+
+// toLowerCamelCase converts a Pascal case name to the camel case used by Prowlarr field names.
+func toLowerCamelCase(name string) string {
+	if name == "" {
+		return name
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// IsNotFoundError checks if the API call failed because the requested object does not exist.
+func IsNotFoundError(response *http.Response, err error) bool {
+	if response != nil {
+		return response.StatusCode == http.StatusNotFound
+	}
+
+	if e, ok := err.(*prowlarr.GenericOpenAPIError); ok {
+		return strings.HasPrefix(e.Error(), strconv.Itoa(http.StatusNotFound))
+	}
+
+	return false
+}
+
+// HandleReadError manages a read error: if the resource is not found it is removed from state,
+// so that terraform can plan its recreation, otherwise a client error is returned.
+func HandleReadError(ctx context.Context, name string, response *http.Response, err error, resp *resource.ReadResponse) {
+	if IsNotFoundError(response, err) {
+		tflog.Warn(ctx, name+" not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.AddError(ClientError, ParseClientError(Read, name, err))
+}
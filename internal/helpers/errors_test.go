@@ -1,10 +1,19 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,6 +38,12 @@ func TestParseClientError(t *testing.T) {
 			err:      errors.New("other error"),
 			expected: "Unable to create prowlarr_tag, got error: other error",
 		},
+		"read only": {
+			action:   "delete",
+			name:     "prowlarr_tag",
+			err:      ErrReadOnly,
+			expected: "Unable to delete prowlarr_tag, the provider is configured with `read_only = true` and no change can be applied.\nDetails:\nmutating request rejected in read-only mode",
+		},
 	}
 	for name, test := range tests {
 		test := test
@@ -65,3 +80,149 @@ func TestParseNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		response *http.Response
+		err      error
+		expected bool
+	}{
+		"not found": {
+			response: &http.Response{StatusCode: http.StatusNotFound},
+			err:      &prowlarr.GenericOpenAPIError{},
+			expected: true,
+		},
+		"unauthorized": {
+			response: &http.Response{StatusCode: http.StatusUnauthorized},
+			err:      &prowlarr.GenericOpenAPIError{},
+			expected: false,
+		},
+		"no response": {
+			response: nil,
+			err:      errors.New("connection refused"),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsNotFoundError(test.response, test.err))
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`[
+			{"propertyName":"BaseUrl","errorMessage":"Invalid URL","isWarning":false},
+			{"propertyName":"ApiKey","errorMessage":"Unauthorized","isWarning":false},
+			{"propertyName":"","errorMessage":"Check your settings","isWarning":true}
+		]`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := prowlarr.NewConfiguration()
+	config.Servers = prowlarr.ServerConfigurations{{URL: server.URL}}
+	_, _, err := prowlarr.NewAPIClient(config).TagAPI.CreateTag(context.Background()).TagResource(*prowlarr.NewTagResource()).Execute()
+	assert.Error(t, err)
+
+	tests := map[string]struct {
+		attributes map[string]schema.Attribute
+		paths      []path.Path
+	}{
+		"attribute": {
+			attributes: map[string]schema.Attribute{
+				"base_url": schema.StringAttribute{Optional: true},
+				"api_key":  schema.StringAttribute{Optional: true},
+			},
+			paths: []path.Path{path.Root("base_url"), path.Root("api_key"), path.Empty()},
+		},
+		"fields": {
+			attributes: map[string]schema.Attribute{
+				"base_url": schema.StringAttribute{Optional: true},
+				"fields":   schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			},
+			paths: []path.Path{path.Root("base_url"), path.Root("fields"), path.Empty()},
+		},
+		"none": {
+			attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{Optional: true},
+			},
+			paths: []path.Path{path.Empty(), path.Empty(), path.Empty()},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stateSchema := schema.Schema{Attributes: test.attributes}
+			state := tfsdk.State{
+				Schema: stateSchema,
+				Raw:    tftypes.NewValue(stateSchema.Type().TerraformType(context.Background()), nil),
+			}
+
+			var diags diag.Diagnostics
+
+			AddClientError(context.Background(), &diags, state, Create, "prowlarr_test", err)
+			assert.Equal(t, 2, diags.ErrorsCount())
+			assert.Equal(t, 1, diags.WarningsCount())
+
+			for i, d := range diags {
+				var attrPath path.Path
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attrPath = withPath.Path()
+				}
+
+				assert.True(t, test.paths[i].Equal(attrPath), "expected %s, got %s", test.paths[i], attrPath)
+			}
+
+			if test.paths[1].Equal(path.Root("fields")) {
+				assert.Contains(t, diags[1].Detail(), "fields[name=apiKey]")
+			}
+		})
+	}
+}
+
+func TestAddClientErrorGeneric(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	AddClientError(context.Background(), &diags, nil, Update, "prowlarr_test", errors.New("other error"))
+	assert.Equal(t, diag.Diagnostics{diag.NewErrorDiagnostic(ClientError, "Unable to update prowlarr_test, got error: other error")}, diags)
+}
+
+func TestAddClientErrorWarningsOnly(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`[{"propertyName":"","errorMessage":"Check your settings","isWarning":true}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := prowlarr.NewConfiguration()
+	config.Servers = prowlarr.ServerConfigurations{{URL: server.URL}}
+	_, _, err := prowlarr.NewAPIClient(config).TagAPI.CreateTag(context.Background()).TagResource(*prowlarr.NewTagResource()).Execute()
+	assert.Error(t, err)
+
+	var diags diag.Diagnostics
+
+	AddClientError(context.Background(), &diags, nil, Create, "prowlarr_test", err)
+	assert.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, ClientError, diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "Check your settings")
+}
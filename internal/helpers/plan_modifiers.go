@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const maskedDescription = "Once the value is masked in state (e.g. after import), the configured value is sent once, since the real value cannot be read back and compared."
+
+// IsMasked checks if the value is the masked placeholder returned by the API for sensitive fields.
+func IsMasked(value attr.Value) bool {
+	s, ok := value.(types.String)
+
+	return ok && !s.IsNull() && !s.IsUnknown() && s.ValueString() == SensitiveValue
+}
+
+// AddMaskedWarning warns that the masked prior state value is replaced by the configured one.
+func AddMaskedWarning(diags *diag.Diagnostics, attrPath path.Path) {
+	diags.AddAttributeWarning(attrPath, MaskedValueWarning,
+		fmt.Sprintf("The value of %s is masked in state (e.g. after import) and cannot be compared with the configuration. "+
+			"The configured value is sent once to Prowlarr and then tracked in state.", attrPath))
+}
+
+// ReplaceMasked returns a plan modifier that plans the configured value in place of the masked prior state one
+// for sensitive attributes, with a warning. From then on the configured value is tracked in state.
+func ReplaceMasked() planmodifier.String {
+	return replaceMaskedModifier{}
+}
+
+type replaceMaskedModifier struct{}
+
+func (m replaceMaskedModifier) Description(_ context.Context) string {
+	return maskedDescription
+}
+
+func (m replaceMaskedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m replaceMaskedModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || !IsMasked(req.StateValue) || IsMasked(req.ConfigValue) {
+		return
+	}
+
+	resp.PlanValue = req.ConfigValue
+	AddMaskedWarning(&resp.Diagnostics, req.Path)
+}
+
+// ReplaceMaskedMap returns a plan modifier that plans the configured values in place of the masked prior state ones
+// of a sensitive string map, key by key, with a warning.
+func ReplaceMaskedMap() planmodifier.Map {
+	return replaceMaskedMapModifier{}
+}
+
+type replaceMaskedMapModifier struct{}
+
+func (m replaceMaskedMapModifier) Description(_ context.Context) string {
+	return maskedDescription
+}
+
+func (m replaceMaskedMapModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m replaceMaskedMapModifier) PlanModifyMap(_ context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if req.StateValue.IsNull() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	state := req.StateValue.Elements()
+
+	for key, value := range req.ConfigValue.Elements() {
+		if prior, ok := state[key]; ok && IsMasked(prior) && !value.IsUnknown() && !IsMasked(value) {
+			AddMaskedWarning(&resp.Diagnostics, req.Path.AtMapKey(key))
+		}
+	}
+}
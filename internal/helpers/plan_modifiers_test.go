@@ -0,0 +1,117 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaceMasked(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   types.String
+		state    types.String
+		expected types.String
+		warning  bool
+	}{
+		"masked": {
+			config:   types.StringValue("secret"),
+			state:    types.StringValue(SensitiveValue),
+			expected: types.StringValue("secret"),
+			warning:  true,
+		},
+		"known": {
+			config:   types.StringValue("secret"),
+			state:    types.StringValue("old"),
+			expected: types.StringValue("secret"),
+		},
+		"removed": {
+			config:   types.StringNull(),
+			state:    types.StringValue(SensitiveValue),
+			expected: types.StringNull(),
+		},
+		"create": {
+			config:   types.StringValue("secret"),
+			state:    types.StringNull(),
+			expected: types.StringValue("secret"),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				ConfigValue: test.config,
+				PlanValue:   test.config,
+				StateValue:  test.state,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			ReplaceMasked().PlanModifyString(context.Background(), req, resp)
+			assert.Equal(t, test.expected, resp.PlanValue)
+			assert.Equal(t, test.warning, resp.Diagnostics.WarningsCount() == 1)
+			assert.False(t, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestReplaceMaskedMap(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   map[string]string
+		state    map[string]string
+		warnings int
+	}{
+		"masked": {
+			config:   map[string]string{"password": "secret", "cookie": "new"},
+			state:    map[string]string{"password": SensitiveValue, "cookie": "old"},
+			warnings: 1,
+		},
+		"new key": {
+			config: map[string]string{"password": "secret"},
+			state:  map[string]string{"cookie": SensitiveValue},
+		},
+		"known": {
+			config: map[string]string{"password": "secret"},
+			state:  map[string]string{"password": "secret"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.MapRequest{
+				ConfigValue: stringMap(test.config),
+				PlanValue:   stringMap(test.config),
+				StateValue:  stringMap(test.state),
+			}
+			resp := &planmodifier.MapResponse{PlanValue: req.PlanValue}
+
+			ReplaceMaskedMap().PlanModifyMap(context.Background(), req, resp)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, test.warnings, resp.Diagnostics.WarningsCount())
+			assert.Equal(t, stringMap(test.config), resp.PlanValue)
+		})
+	}
+}
+
+func stringMap(values map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		elements[k] = types.StringValue(v)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
@@ -3,7 +3,9 @@ package helpers
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,3 +34,111 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// ImportLookup defines how to resolve import identifiers in the form `<key>:<value>`.
+type ImportLookup[T any] struct {
+	// Keys maps each supported key to the function returning the value to match.
+	Keys map[string]func(*T) string
+	// List returns all the resources to match against.
+	List func() ([]T, error)
+	// ID returns the resource ID.
+	ID func(*T) int32
+	// Implementation returns the resource implementation, if any.
+	Implementation func(*T) string
+	// Expected is the implementation managed by a typed resource, resources of other implementations are rejected.
+	// Any implementation is accepted if empty.
+	Expected string
+}
+
+// formats returns the supported import identifier formats.
+func (l ImportLookup[T]) formats() string {
+	formats := []string{"ID"}
+	for key := range l.Keys {
+		formats = append(formats, key+":<"+key+">")
+	}
+
+	slices.Sort(formats[1:])
+
+	return strings.Join(formats, ", ")
+}
+
+// ImportStateLookupIntID is a helper function to set the import identifier
+// to a given state attribute path. Besides the numeric ID, it accepts
+// identifiers in the form `<key>:<value>` resolved by listing the resources
+// through the given lookup. The attribute must accept a int value.
+func ImportStateLookupIntID[T any](ctx context.Context, name string, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse, lookup ImportLookup[T]) {
+	key, value, found := strings.Cut(req.ID, ":")
+	if _, err := strconv.Atoi(req.ID); err == nil || !found {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				UnexpectedImportIdentifier,
+				fmt.Sprintf("Expected import identifier with format: %s. Got: %s", lookup.formats(), req.ID),
+			)
+
+			return
+		}
+
+		ImportStatePassthroughIntID(ctx, attrPath, req, resp)
+
+		return
+	}
+
+	getKey, ok := lookup.Keys[key]
+	if !ok {
+		resp.Diagnostics.AddError(
+			UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %s", lookup.formats(), req.ID),
+		)
+
+		return
+	}
+
+	items, err := lookup.List()
+	if err != nil {
+		resp.Diagnostics.AddError(ClientError, ParseClientError(List, name, err))
+
+		return
+	}
+
+	var (
+		ids        []int32
+		mismatches []string
+	)
+
+	for i := range items {
+		if getKey(&items[i]) != value {
+			continue
+		}
+
+		if lookup.Expected != "" && lookup.Implementation(&items[i]) != lookup.Expected {
+			mismatches = append(mismatches, fmt.Sprintf("%d (%s)", lookup.ID(&items[i]), lookup.Implementation(&items[i])))
+
+			continue
+		}
+
+		ids = append(ids, lookup.ID(&items[i]))
+	}
+
+	switch len(ids) {
+	case 0:
+		detail := fmt.Sprintf("No %s found with %s '%s'.", name, key, value)
+		if len(mismatches) > 0 {
+			detail = fmt.Sprintf("No %s found with %s '%s' and implementation '%s', other implementations found (IDs: %s). Use the matching resource type instead.",
+				name, key, value, lookup.Expected, strings.Join(mismatches, ", "))
+		}
+
+		resp.Diagnostics.AddError(UnexpectedImportIdentifier, detail)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, int64(ids[0]))...)
+	default:
+		matches := make([]string, len(ids))
+		for i, id := range ids {
+			matches[i] = strconv.Itoa(int(id))
+		}
+
+		resp.Diagnostics.AddError(
+			"Ambiguous Import Identifier",
+			fmt.Sprintf("Found %d %s with %s '%s' (IDs: %s). Import by ID instead.", len(ids), name, key, value, strings.Join(matches, ", ")),
+		)
+	}
+}
@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+type importItem struct {
+	name           string
+	implementation string
+	id             int32
+}
+
+func TestImportStateLookupIntID(t *testing.T) {
+	t.Parallel()
+
+	lookup := ImportLookup[importItem]{
+		Keys: map[string]func(*importItem) string{
+			"name": func(i *importItem) string { return i.name },
+		},
+		List: func() ([]importItem, error) {
+			return []importItem{
+				{name: "first", implementation: "Sonarr", id: 1},
+				{name: "second", implementation: "Sonarr", id: 2},
+				{name: "second", implementation: "Sonarr", id: 3},
+				{name: "other", implementation: "Radarr", id: 5},
+				{name: "mixed", implementation: "Radarr", id: 6},
+				{name: "mixed", implementation: "Sonarr", id: 7},
+			}, nil
+		},
+		ID:             func(i *importItem) int32 { return i.id },
+		Implementation: func(i *importItem) string { return i.implementation },
+		Expected:       "Sonarr",
+	}
+
+	tests := map[string]struct {
+		id       string
+		expected types.Int64
+		err      string
+	}{
+		"id": {
+			id:       "4",
+			expected: types.Int64Value(4),
+		},
+		"name": {
+			id:       "name:first",
+			expected: types.Int64Value(1),
+		},
+		"ambiguous": {
+			id:       "name:second",
+			expected: types.Int64Null(),
+			err:      "Ambiguous Import Identifier",
+		},
+		"other implementation": {
+			id:       "name:other",
+			expected: types.Int64Null(),
+			err:      UnexpectedImportIdentifier,
+		},
+		"mixed implementations": {
+			id:       "name:mixed",
+			expected: types.Int64Value(7),
+		},
+		"not found": {
+			id:       "name:third",
+			expected: types.Int64Null(),
+			err:      UnexpectedImportIdentifier,
+		},
+		"unknown key": {
+			id:       "label:first",
+			expected: types.Int64Null(),
+			err:      UnexpectedImportIdentifier,
+		},
+		"invalid": {
+			id:       "first",
+			expected: types.Int64Null(),
+			err:      UnexpectedImportIdentifier,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stateSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{Computed: true},
+				},
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: stateSchema,
+					Raw:    tftypes.NewValue(stateSchema.Type().TerraformType(context.Background()), nil),
+				},
+			}
+
+			ImportStateLookupIntID(context.Background(), "prowlarr_test", path.Root("id"), resource.ImportStateRequest{ID: test.id}, resp, lookup)
+
+			if test.err != "" {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, test.err, resp.Diagnostics.Errors()[0].Summary())
+
+				return
+			}
+
+			var id types.Int64
+
+			assert.False(t, resp.Diagnostics.HasError())
+			resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
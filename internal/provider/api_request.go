@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+)
+
+var errAPIRequest = errors.New("unexpected response")
+
+// apiRequest calls an API endpoint not covered by the SDK, with the same HTTP client, server and authentication.
+// The body is sent as JSON if not nil, and the JSON response is decoded into result if not nil.
+func apiRequest(auth context.Context, client *prowlarr.APIClient, method, endpoint string, body, result any) (*http.Response, error) {
+	config := client.GetConfig()
+
+	basePath, err := config.ServerURLWithContext(auth, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader
+
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		reader = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequestWithContext(auth, method, basePath+endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range config.DefaultHeader {
+		req.Header.Set(name, value)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", config.UserAgent)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if keys, ok := auth.Value(prowlarr.ContextAPIKeys).(map[string]prowlarr.APIKey); ok {
+		if key, ok := keys["X-Api-Key"]; ok {
+			req.Header.Set("X-Api-Key", key.Key)
+		}
+	}
+
+	resp, err := config.HTTPClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, fmt.Errorf("%w: %s\nDetails:\n%s", errAPIRequest, resp.Status, content)
+	}
+
+	if result != nil {
+		return resp, json.Unmarshal(content, result)
+	}
+
+	return resp, nil
+}
@@ -26,6 +26,7 @@ func NewApplicationDataSource() datasource.DataSource {
 type ApplicationDataSource struct {
 	client *prowlarr.APIClient
 	auth   context.Context
+	cache  *ListCache
 }
 
 func (d *ApplicationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -94,6 +95,7 @@ func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.Co
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.cache = dataSourceListCache(req)
 	}
 }
 
@@ -106,7 +108,7 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 	// Get application current value
-	response, _, err := d.client.ApplicationAPI.ListApplications(d.auth).Execute()
+	response, _, err := cachedList(d.cache, applicationListPath, d.client.ApplicationAPI.ListApplications(d.auth).Execute)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationDataSourceName, err))
 
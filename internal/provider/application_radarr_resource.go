@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &ApplicationRadarrResource{}
 	_ resource.ResourceWithImportState = &ApplicationRadarrResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationRadarrResource{}
 )
 
 func NewApplicationRadarrResource() resource.Resource {
@@ -37,20 +38,24 @@ func NewApplicationRadarrResource() resource.Resource {
 
 // ApplicationRadarrResource defines the application implementation.
 type ApplicationRadarrResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // ApplicationRadarr describes the application data model.
 type ApplicationRadarr struct {
 	SyncCategories types.Set    `tfsdk:"sync_categories"`
 	Tags           types.Set    `tfsdk:"tags"`
+	TagsAll        types.Set    `tfsdk:"tags_all"`
 	Name           types.String `tfsdk:"name"`
 	SyncLevel      types.String `tfsdk:"sync_level"`
 	ProwlarrURL    types.String `tfsdk:"prowlarr_url"`
 	BaseURL        types.String `tfsdk:"base_url"`
 	APIKey         types.String `tfsdk:"api_key"`
 	ID             types.Int64  `tfsdk:"id"`
+	ForceSave      types.Bool   `tfsdk:"force_save"`
+	SyncOnApply    types.Bool   `tfsdk:"sync_on_apply"`
 }
 
 func (a ApplicationRadarr) toApplication() *Application {
@@ -104,6 +109,19 @@ func (r *ApplicationRadarrResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the application without testing it first, as Prowlarr `forceSave` does. Otherwise enabled applications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
+			"sync_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Run the application indexer sync for this application after create and update, waiting for its completion. Only the command status is checked, so errors that Prowlarr logs for the application while completing the sync are not detected. A failed sync fails the update, while it is reported as a warning on create, since the application is already saved. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Application ID.",
 				Computed:            true,
@@ -124,6 +142,9 @@ func (r *ApplicationRadarrResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "API key.",
 				Required:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"sync_categories": schema.SetAttribute{
 				MarkdownDescription: "Sync categories.",
@@ -139,9 +160,14 @@ func (r *ApplicationRadarrResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *ApplicationRadarrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *ApplicationRadarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var application *ApplicationRadarr
@@ -154,18 +180,39 @@ func (r *ApplicationRadarrResource) Create(ctx context.Context, req resource.Cre
 
 	// Create new ApplicationRadarr
 	request := application.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ApplicationResource(*request).Execute()
+	// Test before saving, as Prowlarr does for enabled ones
+	if !application.ForceSave.ValueBool() && applicationEnabled(request) {
+		if _, err := r.client.ApplicationAPI.TestApplications(r.auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, applicationRadarrResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ForceSave(application.ForceSave.ValueBool()).ApplicationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationRadarrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, applicationRadarrResourceName, err)
 
 		return
 	}
 
+	response.Tags, application.TagsAll = r.defaultTags.split(ctx, response.Tags, application.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+applicationRadarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	application.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &application)...)
+
+	// Sync the indexers to the application, if requested
+	if application.SyncOnApply.ValueBool() {
+		syncCreatedApplicationIndexers(ctx, r.auth, r.client, response.GetId(), &resp.Diagnostics)
+	}
 }
 
 func (r *ApplicationRadarrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -179,13 +226,15 @@ func (r *ApplicationRadarrResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get ApplicationRadarr current value
-	response, _, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationRadarrResourceName, err))
+		helpers.HandleReadError(ctx, applicationRadarrResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, application.TagsAll = r.defaultTags.split(ctx, response.Tags, application.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+applicationRadarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	application.write(ctx, response, &resp.Diagnostics)
@@ -204,18 +253,39 @@ func (r *ApplicationRadarrResource) Update(ctx context.Context, req resource.Upd
 
 	// Update ApplicationRadarr
 	request := application.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !application.ForceSave.ValueBool() && applicationEnabled(request) {
+		if _, err := r.client.ApplicationAPI.TestApplications(r.auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, applicationRadarrResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(application.ForceSave.ValueBool()).ApplicationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationRadarrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, applicationRadarrResourceName, err)
 
 		return
 	}
 
+	response.Tags, application.TagsAll = r.defaultTags.split(ctx, response.Tags, application.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+applicationRadarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	application.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &application)...)
+
+	// Sync the indexers to the application, if requested
+	if application.SyncOnApply.ValueBool() {
+		syncApplicationIndexers(ctx, r.auth, r.client, response.GetId(), &resp.Diagnostics)
+	}
 }
 
 func (r *ApplicationRadarrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -240,7 +310,7 @@ func (r *ApplicationRadarrResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ApplicationRadarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, applicationRadarrResourceName, path.Root("id"), req, resp, applicationImportLookup(r.auth, r.client, applicationRadarrImplementation))
 	tflog.Trace(ctx, "imported "+applicationRadarrResourceName+": "+req.ID)
 }
 
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
@@ -20,12 +21,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const applicationResourceName = "application"
+const (
+	applicationResourceName       = "application"
+	applicationIndexerSyncCommand = "ApplicationIndexerSync"
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &ApplicationResource{}
 	_ resource.ResourceWithImportState = &ApplicationResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationResource{}
 )
 
 var applicationFields = helpers.Fields{
@@ -39,8 +44,9 @@ func NewApplicationResource() resource.Resource {
 
 // ApplicationResource defines the application implementation.
 type ApplicationResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // Application describes the application data model.
@@ -58,6 +64,15 @@ type Application struct {
 	ID                  types.Int64  `tfsdk:"id"`
 }
 
+// ApplicationResourceData adds the resource only attributes to the application data model,
+// which is shared with the data sources.
+type ApplicationResourceData struct {
+	Application
+	TagsAll     types.Set  `tfsdk:"tags_all"`
+	ForceSave   types.Bool `tfsdk:"force_save"`
+	SyncOnApply types.Bool `tfsdk:"sync_on_apply"`
+}
+
 func (a Application) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -108,6 +123,19 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the application without testing it first, as Prowlarr `forceSave` does. Otherwise enabled applications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
+			"sync_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Run the application indexer sync for this application after create and update, waiting for its completion. Only the command status is checked, so errors that Prowlarr logs for the application while completing the sync are not detected. A failed sync fails the update, while it is reported as a warning on create, since the application is already saved. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Application ID.",
 				Computed:            true,
@@ -131,6 +159,9 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"sync_categories": schema.SetAttribute{
 				MarkdownDescription: "Sync categories.",
@@ -152,12 +183,17 @@ func (r *ApplicationResource) Configure(ctx context.Context, req resource.Config
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var application *Application
+	var application *ApplicationResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &application)...)
 
@@ -167,27 +203,48 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Create new Application
 	request := application.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ApplicationResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !application.ForceSave.ValueBool() && applicationEnabled(request) {
+		if _, err := r.client.ApplicationAPI.TestApplications(r.auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, applicationResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.ApplicationAPI.CreateApplications(r.auth).ForceSave(application.ForceSave.ValueBool()).ApplicationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, applicationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, applicationResourceName, err)
 
 		return
 	}
 
+	response.Tags, application.TagsAll = r.defaultTags.split(ctx, response.Tags, application.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	state := ApplicationResourceData{ForceSave: application.ForceSave, SyncOnApply: application.SyncOnApply}
 
-	state.writeSensitive(application)
+	state.writeSensitive(&application.Application)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	// Sync the indexers to the application, if requested
+	if application.SyncOnApply.ValueBool() {
+		syncCreatedApplicationIndexers(ctx, r.auth, r.client, response.GetId(), &resp.Diagnostics)
+	}
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var application *Application
+	var application *ApplicationResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &application)...)
 
@@ -196,26 +253,28 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get Application current value
-	response, _, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ApplicationAPI.GetApplicationsById(r.auth, int32(application.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, applicationResourceName, err))
+		helpers.HandleReadError(ctx, applicationResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, application.TagsAll = r.defaultTags.split(ctx, response.Tags, application.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	state := ApplicationResourceData{ForceSave: application.ForceSave, SyncOnApply: application.SyncOnApply}
 
-	state.writeSensitive(application)
+	state.writeSensitive(&application.Application)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var application *Application
+	var application *ApplicationResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &application)...)
 
@@ -225,22 +284,43 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update Application
 	request := application.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
 
-	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ApplicationResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !application.ForceSave.ValueBool() && applicationEnabled(request) {
+		if _, err := r.client.ApplicationAPI.TestApplications(r.auth).ApplicationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, applicationResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.ApplicationAPI.UpdateApplications(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(application.ForceSave.ValueBool()).ApplicationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, applicationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, applicationResourceName, err)
 
 		return
 	}
 
+	response.Tags, application.TagsAll = r.defaultTags.split(ctx, response.Tags, application.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+applicationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Application
+	state := ApplicationResourceData{ForceSave: application.ForceSave, SyncOnApply: application.SyncOnApply}
 
-	state.writeSensitive(application)
+	state.writeSensitive(&application.Application)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	// Sync the indexers to the application, if requested
+	if application.SyncOnApply.ValueBool() {
+		syncApplicationIndexers(ctx, r.auth, r.client, response.GetId(), &resp.Diagnostics)
+	}
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -265,10 +345,28 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, applicationResourceName, path.Root("id"), req, resp, applicationImportLookup(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+applicationResourceName+": "+req.ID)
 }
 
+// applicationImportLookup resolves applications by `name:<name>` on import.
+// Typed resources pass their implementation, so that other implementations are rejected.
+func applicationImportLookup(auth context.Context, client *prowlarr.APIClient, implementation string) helpers.ImportLookup[prowlarr.ApplicationResource] {
+	return helpers.ImportLookup[prowlarr.ApplicationResource]{
+		Keys: map[string]func(*prowlarr.ApplicationResource) string{
+			"name": (*prowlarr.ApplicationResource).GetName,
+		},
+		List: func() ([]prowlarr.ApplicationResource, error) {
+			response, _, err := client.ApplicationAPI.ListApplications(auth).Execute()
+
+			return response, err
+		},
+		ID:             (*prowlarr.ApplicationResource).GetId,
+		Implementation: (*prowlarr.ApplicationResource).GetImplementation,
+		Expected:       implementation,
+	}
+}
+
 func (a *Application) write(ctx context.Context, application *prowlarr.ApplicationResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -303,3 +401,26 @@ func (a *Application) writeSensitive(application *Application) {
 		a.APIKey = application.APIKey
 	}
 }
+
+// applicationEnabled mirrors Prowlarr, which tests only enabled applications on save.
+func applicationEnabled(application *prowlarr.ApplicationResource) bool {
+	return application.GetSyncLevel() != prowlarr.APPLICATIONSYNCLEVEL_DISABLED
+}
+
+// syncApplicationIndexers runs the application indexer sync for a single application and waits for its completion.
+// Only the command status is checked, since Prowlarr completes the command even when the application rejects the indexers.
+func syncApplicationIndexers(ctx context.Context, auth context.Context, client *prowlarr.APIClient, id int32, diags *diag.Diagnostics) {
+	runCommand(ctx, auth, client, commandBody{Name: applicationIndexerSyncCommand, ApplicationIDs: []int32{id}}, diags)
+}
+
+// syncCreatedApplicationIndexers runs the application indexer sync after create,
+// reporting its failures as warnings so that the application just saved is not tainted.
+func syncCreatedApplicationIndexers(ctx context.Context, auth context.Context, client *prowlarr.APIClient, id int32, diags *diag.Diagnostics) {
+	var syncDiags diag.Diagnostics
+
+	syncApplicationIndexers(ctx, auth, client, id, &syncDiags)
+
+	for _, d := range syncDiags.Errors() {
+		diags.AddWarning(d.Summary(), fmt.Sprintf("Application %d was created, but its indexer sync failed: %s", id, d.Detail()))
+	}
+}
@@ -1,11 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccApplicationResource(t *testing.T) {
@@ -66,3 +74,77 @@ func testAccApplicationResourceConfig(name, prowlarr string) string {
 		sync_categories = [3000, 3010, 3030]
 	}`, name, prowlarr)
 }
+
+func TestSyncApplicationIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status  string
+		created bool
+		err     bool
+		warning bool
+	}{
+		"completed": {
+			status: "completed",
+		},
+		"failed on update": {
+			status: "failed",
+			err:    true,
+		},
+		"failed on create": {
+			status:  "failed",
+			created: true,
+			warning: true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/command":
+					var body map[string]any
+
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+					assert.Equal(t, "ApplicationIndexerSync", body["name"])
+					assert.Equal(t, []any{float64(3)}, body["applicationIds"])
+
+					_, _ = w.Write([]byte(`{"id":1,"name":"ApplicationIndexerSync","status":"queued"}`))
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/command/1":
+					_, _ = w.Write([]byte(`{"id":1,"name":"ApplicationIndexerSync","status":"` + test.status + `","message":"Unable to connect"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(server.Close)
+
+			apiURL, err := url.Parse(server.URL)
+			assert.NoError(t, err)
+
+			auth := newAuthContext(apiURL, "key")
+			client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+
+			var diags diag.Diagnostics
+
+			if test.created {
+				syncCreatedApplicationIndexers(context.Background(), auth, client, 3, &diags)
+			} else {
+				syncApplicationIndexers(context.Background(), auth, client, 3, &diags)
+			}
+
+			assert.Equal(t, test.err, diags.HasError())
+			assert.Equal(t, test.warning, diags.WarningsCount() > 0)
+
+			if test.err || test.warning {
+				assert.Contains(t, diags[0].Detail(), "Unable to connect")
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	commandResourceName = "command"
+	commandPollInterval = 2 * time.Second
+	commandTimeout      = 30 * time.Minute
+)
+
+var errCommandTimeout = errors.New("command did not complete in time")
+
+// commandBody is the command request, including the command specific parameters not modeled by the SDK.
+type commandBody struct {
+	Name           string  `json:"name"`
+	ApplicationIDs []int32 `json:"applicationIds,omitempty"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+// CommandResource defines the command implementation.
+type CommandResource struct {
+	client *prowlarr.APIClient
+	auth   context.Context
+}
+
+// Command describes the command data model.
+type Command struct {
+	Triggers types.Map    `tfsdk:"triggers"`
+	Name     types.String `tfsdk:"name"`
+	Status   types.String `tfsdk:"status"`
+	Started  types.String `tfsdk:"started"`
+	Ended    types.String `tfsdk:"ended"`
+	Message  types.String `tfsdk:"message"`
+	ID       types.Int64  `tfsdk:"id"`
+}
+
+func (r *CommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + commandResourceName
+}
+
+func (r *CommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nCommand resource.\nIt runs a Prowlarr command (e.g. `ApplicationIndexerSync`, `Backup`, `CheckHealth`) on creation and every time `triggers` change, waiting for its completion.\nDestroying the resource has no effect on Prowlarr.\nFor more information refer to [Tasks](https://wiki.servarr.com/prowlarr/system#tasks) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Command name (e.g. `ApplicationIndexerSync`, `Backup`, `CheckHealth`, `ApplicationUpdate`, `ClearLog`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, runs the command again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Command status.",
+				Computed:            true,
+			},
+			"started": schema.StringAttribute{
+				MarkdownDescription: "Command start time.",
+				Computed:            true,
+			},
+			"ended": schema.StringAttribute{
+				MarkdownDescription: "Command end time.",
+				Computed:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Command message.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *CommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run new Command
+	response := runCommand(ctx, r.auth, r.client, commandBody{Name: command.Name.ValueString()}, &resp.Diagnostics)
+	if response == nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	command.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Commands are purged by Prowlarr after a while, so the state is kept as is.
+	var command *Command
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the configurable attributes require replacement, only the state is updated.
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Completed commands cannot be undone.
+	tflog.Trace(ctx, "deleted "+commandResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+func (c *Command) write(command *prowlarr.CommandResource) {
+	c.ID = types.Int64Value(int64(command.GetId()))
+	c.Status = types.StringValue(string(command.GetStatus()))
+	c.Message = types.StringValue(command.GetMessage())
+	c.Started = types.StringValue(formatNullableTime(command.Started))
+	c.Ended = types.StringValue(formatNullableTime(command.Ended))
+}
+
+func formatNullableTime(value prowlarr.NullableTime) string {
+	if value.Get() == nil {
+		return ""
+	}
+
+	return value.Get().Format(time.RFC3339)
+}
+
+// runCommand posts the command and polls its status until it completes.
+// It returns nil if the command cannot be run or does not complete successfully.
+func runCommand(ctx context.Context, auth context.Context, client *prowlarr.APIClient, body commandBody, diags *diag.Diagnostics) *prowlarr.CommandResource {
+	name := body.Name
+	response := prowlarr.NewCommandResource()
+
+	// The SDK command model has no command specific parameters.
+	_, err := apiRequest(auth, client, http.MethodPost, "/api/v1/command", body, response)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+
+		return nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s %s queued with ID %d", commandResourceName, name, response.GetId()))
+
+	timeout := time.NewTimer(commandTimeout)
+	defer timeout.Stop()
+
+	ticker := time.NewTicker(commandPollInterval)
+	defer ticker.Stop()
+
+	for {
+		switch response.GetStatus() {
+		case prowlarr.COMMANDSTATUS_COMPLETED:
+			return response
+		case prowlarr.COMMANDSTATUS_FAILED, prowlarr.COMMANDSTATUS_ABORTED, prowlarr.COMMANDSTATUS_CANCELLED, prowlarr.COMMANDSTATUS_ORPHANED:
+			diags.AddError(helpers.ClientError, fmt.Sprintf("Command %s %s: %s", name, response.GetStatus(), response.GetMessage()))
+
+			return nil
+		case prowlarr.COMMANDSTATUS_QUEUED, prowlarr.COMMANDSTATUS_STARTED:
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(helpers.ClientError, fmt.Sprintf("Unable to wait for command %s, got error: %s", name, ctx.Err()))
+
+			return nil
+		case <-timeout.C:
+			diags.AddError(helpers.ClientError, fmt.Sprintf("Unable to wait for command %s, got error: %s after %s", name, errCommandTimeout, commandTimeout))
+
+			return nil
+		case <-ticker.C:
+		}
+
+		response, _, err = client.CommandAPI.GetCommandById(auth, response.GetId()).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, commandResourceName, err))
+
+			return nil
+		}
+	}
+}
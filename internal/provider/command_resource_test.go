@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("CheckHealth", "1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("CheckHealth", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("prowlarr_command.test", "id"),
+					resource.TestCheckResourceAttrSet("prowlarr_command.test", "ended"),
+				),
+			},
+			// Trigger testing
+			{
+				Config: testAccCommandResourceConfig("CheckHealth", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttr("prowlarr_command.test", "triggers.run", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(name, run string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_command" "test" {
+		name = "%s"
+		triggers = {
+			run = "%s"
+		}
+	}`, name, run)
+}
+
+func TestRunCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status string
+		err    bool
+	}{
+		"completed": {
+			status: "completed",
+		},
+		"failed": {
+			status: "failed",
+			err:    true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/command":
+					_, _ = w.Write([]byte(`{"id":1,"name":"CheckHealth","status":"queued"}`))
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/command/1":
+					_, _ = w.Write([]byte(`{"id":1,"name":"CheckHealth","status":"` + test.status + `","message":"done","ended":"2024-01-01T10:00:00Z"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(server.Close)
+
+			apiURL, err := url.Parse(server.URL)
+			assert.NoError(t, err)
+
+			var diags diag.Diagnostics
+
+			response := runCommand(context.Background(), newAuthContext(apiURL, "key"), prowlarr.NewAPIClient(prowlarr.NewConfiguration()), commandBody{Name: "CheckHealth"}, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+
+			if test.err {
+				assert.Nil(t, response)
+
+				return
+			}
+
+			var command Command
+
+			command.write(response)
+			assert.Equal(t, "completed", command.Status.ValueString())
+			assert.Equal(t, "done", command.Message.ValueString())
+			assert.Equal(t, "2024-01-01T10:00:00Z", command.Ended.ValueString())
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var errDefaultTagNotFound = errors.New("tag not found")
+
+// DefaultTags holds the provider default tags, added to every taggable resource.
+// Values can be tag IDs or labels, labels are resolved once on first use.
+type DefaultTags struct {
+	auth   context.Context
+	client *prowlarr.APIClient
+	values []string
+	ids    []int32
+	mu     sync.Mutex
+}
+
+// newDefaultTags returns the default tags, possibly empty.
+// Resources have nil default tags only until the provider is configured.
+func newDefaultTags(auth context.Context, client *prowlarr.APIClient, values []string) *DefaultTags {
+	return &DefaultTags{
+		auth:   auth,
+		client: client,
+		values: values,
+	}
+}
+
+// resolve converts the configured values into tag IDs.
+// Errors are not cached, so a tag created later in the same run can still be found.
+func (t *DefaultTags) resolve() ([]int32, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.ids != nil {
+		return t.ids, nil
+	}
+
+	var tags []prowlarr.TagResource
+
+	ids := make([]int32, 0, len(t.values))
+
+	for _, value := range t.values {
+		if id, err := strconv.ParseInt(value, 10, 32); err == nil {
+			ids = append(ids, int32(id))
+
+			continue
+		}
+
+		if tags == nil {
+			response, _, err := t.client.TagAPI.ListTag(t.auth).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			tags = response
+		}
+
+		index := slices.IndexFunc(tags, func(tag prowlarr.TagResource) bool { return tag.GetLabel() == value })
+		if index < 0 {
+			return nil, fmt.Errorf("%w: %s", errDefaultTagNotFound, value)
+		}
+
+		ids = append(ids, tags[index].GetId())
+	}
+
+	t.ids = ids
+
+	return t.ids, nil
+}
+
+// merge adds the default tags to the ones sent to Prowlarr.
+func (t *DefaultTags) merge(tags []int32, diags *diag.Diagnostics) []int32 {
+	ids, err := t.resolve()
+	if err != nil {
+		diags.AddError(helpers.ClientError, fmt.Sprintf("Unable to resolve provider default_tags, got error: %s", err))
+
+		return tags
+	}
+
+	return appendMissingTags(tags, ids)
+}
+
+// split returns the tags read from Prowlarr without the default tags not explicitly configured,
+// together with all of them for `tags_all`.
+func (t *DefaultTags) split(ctx context.Context, tags []int32, configured types.Set, diags *diag.Diagnostics) ([]int32, types.Set) {
+	tagsAll, tempDiag := types.SetValueFrom(ctx, types.Int64Type, tags)
+	diags.Append(tempDiag...)
+
+	return t.strip(ctx, tags, configured), tagsAll
+}
+
+// modifyPlan plans `tags_all` as the configured tags merged with the default tags,
+// so that changes to the default tags, or default tags removed outside Terraform, are applied.
+func (t *DefaultTags) modifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || t == nil {
+		return
+	}
+
+	var tags []int32
+
+	tagsPath := path.Root("tags")
+	tagsAllPath := path.Root("tags_all")
+	configured := types.SetNull(types.Int64Type)
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, tagsPath, &configured)...)
+
+	if resp.Diagnostics.HasError() || configured.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, types.SetUnknown(types.Int64Type))...)
+
+		return
+	}
+
+	resp.Diagnostics.Append(configured.ElementsAs(ctx, &tags, true)...)
+
+	// Labels of tags created in the same run are only resolved on apply.
+	ids, err := t.resolve()
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to resolve provider default_tags on plan, got error: %s", err))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, types.SetUnknown(types.Int64Type))...)
+
+		return
+	}
+
+	tagsAll, tempDiag := types.SetValueFrom(ctx, types.Int64Type, appendMissingTags(tags, ids))
+	resp.Diagnostics.Append(tempDiag...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, tagsAll)...)
+}
+
+// strip removes the default tags not explicitly configured from the ones read from Prowlarr,
+// so that the resource tags keep matching the configuration.
+func (t *DefaultTags) strip(ctx context.Context, tags []int32, configured types.Set) []int32 {
+	ids, err := t.resolve()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to resolve provider default_tags, got error: %s", err))
+
+		return tags
+	}
+
+	if len(ids) == 0 {
+		return tags
+	}
+
+	keep := make([]int64, 0, len(configured.Elements()))
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configured.ElementsAs(ctx, &keep, true)
+	}
+
+	output := make([]int32, 0, len(tags))
+
+	for _, id := range tags {
+		if slices.Contains(ids, id) && !slices.Contains(keep, int64(id)) {
+			continue
+		}
+
+		output = append(output, id)
+	}
+
+	return output
+}
+
+// appendMissingTags adds the given IDs to the tags, skipping the ones already present.
+func appendMissingTags(tags, ids []int32) []int32 {
+	for _, id := range ids {
+		if !slices.Contains(tags, id) {
+			tags = append(tags, id)
+		}
+	}
+
+	return tags
+}
+
+// resourceDefaultTags returns the provider default tags for a specific resource.
+func resourceDefaultTags(req resource.ConfigureRequest) *DefaultTags {
+	if providerData, ok := req.ProviderData.(*ProwlarrData); ok {
+		return providerData.DefaultTags
+	}
+
+	return nil
+}
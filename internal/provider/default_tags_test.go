@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tag" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"label":"terraform"},{"id":2,"label":"private"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	auth := newAuthContext(apiURL, "key")
+	client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+
+	tests := map[string]struct {
+		values     []string
+		tags       []int32
+		configured []int64
+		merged     []int32
+		stripped   []int32
+		err        bool
+	}{
+		"none": {
+			tags:     []int32{3},
+			merged:   []int32{3},
+			stripped: []int32{3},
+		},
+		"label": {
+			values:   []string{"terraform"},
+			tags:     []int32{3},
+			merged:   []int32{3, 1},
+			stripped: []int32{3},
+		},
+		"id and label": {
+			values:   []string{"5", "private"},
+			tags:     nil,
+			merged:   []int32{5, 2},
+			stripped: []int32{},
+		},
+		"configured": {
+			values:     []string{"terraform"},
+			tags:       []int32{1},
+			configured: []int64{1},
+			merged:     []int32{1},
+			stripped:   []int32{1},
+		},
+		"not found": {
+			values: []string{"missing"},
+			tags:   []int32{3},
+			merged: []int32{3},
+			err:    true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			defaultTags := newDefaultTags(auth, client, test.values)
+
+			merged := defaultTags.merge(test.tags, &diags)
+			assert.Equal(t, test.merged, merged)
+			assert.Equal(t, test.err, diags.HasError())
+
+			if test.err {
+				return
+			}
+
+			configured, _ := types.SetValueFrom(context.Background(), types.Int64Type, test.configured)
+			tagsAll, _ := types.SetValueFrom(context.Background(), types.Int64Type, merged)
+			stripped, splitAll := defaultTags.split(context.Background(), merged, configured, &diags)
+			assert.Equal(t, test.stripped, stripped)
+			assert.Equal(t, tagsAll, splitAll)
+			assert.False(t, diags.HasError())
+		})
+	}
+}
+
+func TestDefaultTagsModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"label":"terraform"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	auth := newAuthContext(apiURL, "key")
+	client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+	ctx := context.Background()
+
+	planSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags":     schema.SetAttribute{Optional: true, Computed: true, ElementType: types.Int64Type},
+			"tags_all": schema.SetAttribute{Computed: true, ElementType: types.Int64Type},
+		},
+	}
+
+	set := func(values ...int64) types.Set {
+		value, _ := types.SetValueFrom(ctx, types.Int64Type, values)
+
+		return value
+	}
+
+	tests := map[string]struct {
+		defaultTags *DefaultTags
+		tags        types.Set
+		expected    types.Set
+	}{
+		"merged": {
+			defaultTags: newDefaultTags(auth, client, []string{"terraform", "5"}),
+			tags:        set(3),
+			expected:    set(3, 1, 5),
+		},
+		"already configured": {
+			defaultTags: newDefaultTags(auth, client, []string{"terraform"}),
+			tags:        set(1),
+			expected:    set(1),
+		},
+		"no default tags": {
+			defaultTags: newDefaultTags(auth, client, nil),
+			tags:        set(3),
+			expected:    set(3),
+		},
+		"null tags": {
+			defaultTags: newDefaultTags(auth, client, []string{"terraform"}),
+			tags:        types.SetNull(types.Int64Type),
+			expected:    set(1),
+		},
+		"unknown tags": {
+			defaultTags: newDefaultTags(auth, client, []string{"terraform"}),
+			tags:        types.SetUnknown(types.Int64Type),
+			expected:    types.SetUnknown(types.Int64Type),
+		},
+		"label not found": {
+			defaultTags: newDefaultTags(auth, client, []string{"missing"}),
+			tags:        set(3),
+			expected:    types.SetUnknown(types.Int64Type),
+		},
+		"provider not configured": {
+			tags:     set(3),
+			expected: types.SetUnknown(types.Int64Type),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{
+				Schema: planSchema,
+				Raw: tftypes.NewValue(planSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"tags":     tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, tftypes.UnknownValue),
+					"tags_all": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, tftypes.UnknownValue),
+				}),
+			}
+			assert.False(t, plan.SetAttribute(ctx, path.Root("tags"), test.tags).HasError())
+
+			req := resource.ModifyPlanRequest{Plan: plan}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			test.defaultTags.modifyPlan(ctx, req, resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var tagsAll types.Set
+
+			resp.Plan.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)
+			assert.True(t, test.expected.Equal(tagsAll), "expected %s, got %s", test.expected, tagsAll)
+		})
+	}
+}
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientAria2Resource{}
 	_ resource.ResourceWithImportState = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientAria2Resource{}
 )
 
 func NewDownloadClientAria2Resource() resource.Resource {
@@ -35,13 +36,15 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // DownloadClientAria2 describes the download client data model.
 type DownloadClientAria2 struct {
 	Tags        types.Set    `tfsdk:"tags"`
+	TagsAll     types.Set    `tfsdk:"tags_all"`
 	Categories  types.Set    `tfsdk:"categories"`
 	Name        types.String `tfsdk:"name"`
 	Host        types.String `tfsdk:"host"`
@@ -52,6 +55,7 @@ type DownloadClientAria2 struct {
 	ID          types.Int64  `tfsdk:"id"`
 	UseSsl      types.Bool   `tfsdk:"use_ssl"`
 	Enable      types.Bool   `tfsdk:"enable"`
+	ForceSave   types.Bool   `tfsdk:"force_save"`
 }
 
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
@@ -115,6 +119,11 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"categories": schema.SetNestedAttribute{
 				MarkdownDescription: "List of mapped categories.",
 				Computed:            true,
@@ -122,6 +131,10 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 					Attributes: DownloadClientResource{}.getClientCategorySchema().Attributes,
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the download client without testing it first, as Prowlarr `forceSave` does. Otherwise enabled download clients are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -163,9 +176,14 @@ func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *DownloadClientAria2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientAria2
@@ -178,14 +196,30 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientAria2ResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, downloadClientAria2ResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -203,13 +237,15 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientAria2ResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
@@ -228,14 +264,30 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientAria2ResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, downloadClientAria2ResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -264,7 +316,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, downloadClientAria2ResourceName, path.Root("id"), req, resp, downloadClientImportLookup(r.auth, r.client, downloadClientAria2Implementation))
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
 
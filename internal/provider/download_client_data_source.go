@@ -26,6 +26,7 @@ func NewDownloadClientDataSource() datasource.DataSource {
 type DownloadClientDataSource struct {
 	client *prowlarr.APIClient
 	auth   context.Context
+	cache  *ListCache
 }
 
 func (d *DownloadClientDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -235,6 +236,7 @@ func (d *DownloadClientDataSource) Configure(ctx context.Context, req datasource
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.cache = dataSourceListCache(req)
 	}
 }
 
@@ -247,7 +249,7 @@ func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	// Get downloadClient current value
-	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
+	response, _, err := cachedList(d.cache, downloadClientListPath, d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDataSourceName, err))
 
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientDelugeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientDelugeResource{}
 )
 
 func NewDownloadClientDelugeResource() resource.Resource {
@@ -37,13 +38,15 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // DownloadClientDeluge describes the download client data model.
 type DownloadClientDeluge struct {
 	Tags         types.Set    `tfsdk:"tags"`
+	TagsAll      types.Set    `tfsdk:"tags_all"`
 	Categories   types.Set    `tfsdk:"categories"`
 	Name         types.String `tfsdk:"name"`
 	Host         types.String `tfsdk:"host"`
@@ -57,6 +60,7 @@ type DownloadClientDeluge struct {
 	AddPaused    types.Bool   `tfsdk:"add_paused"`
 	UseSsl       types.Bool   `tfsdk:"use_ssl"`
 	Enable       types.Bool   `tfsdk:"enable"`
+	ForceSave    types.Bool   `tfsdk:"force_save"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
@@ -126,6 +130,11 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"categories": schema.SetNestedAttribute{
 				MarkdownDescription: "List of mapped categories.",
 				Optional:            true,
@@ -134,6 +143,10 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 					Attributes: DownloadClientResource{}.getClientCategorySchema().Attributes,
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the download client without testing it first, as Prowlarr `forceSave` does. Otherwise enabled download clients are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -185,6 +198,9 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 		},
 	}
@@ -194,9 +210,14 @@ func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *DownloadClientDelugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientDeluge
@@ -209,14 +230,30 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientDelugeResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, downloadClientDelugeResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -234,13 +271,15 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientDelugeResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
@@ -259,14 +298,30 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientDelugeResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, downloadClientDelugeResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -295,7 +350,7 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, downloadClientDelugeResourceName, path.Root("id"), req, resp, downloadClientImportLookup(r.auth, r.client, downloadClientDelugeImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
 }
 
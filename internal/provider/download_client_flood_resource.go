@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientFloodResource{}
 	_ resource.ResourceWithImportState = &DownloadClientFloodResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientFloodResource{}
 )
 
 func NewDownloadClientFloodResource() resource.Resource {
@@ -35,13 +36,15 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // DownloadClientFlood describes the download client data model.
 type DownloadClientFlood struct {
 	Tags           types.Set    `tfsdk:"tags"`
+	TagsAll        types.Set    `tfsdk:"tags_all"`
 	Categories     types.Set    `tfsdk:"categories"`
 	FieldTags      types.Set    `tfsdk:"field_tags"`
 	AdditionalTags types.Set    `tfsdk:"additional_tags"`
@@ -57,6 +60,7 @@ type DownloadClientFlood struct {
 	AddPaused      types.Bool   `tfsdk:"add_paused"`
 	UseSsl         types.Bool   `tfsdk:"use_ssl"`
 	Enable         types.Bool   `tfsdk:"enable"`
+	ForceSave      types.Bool   `tfsdk:"force_save"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
@@ -130,6 +134,11 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"categories": schema.SetNestedAttribute{
 				MarkdownDescription: "List of mapped categories.",
 				Optional:            true,
@@ -138,6 +147,10 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 					Attributes: DownloadClientResource{}.getClientCategorySchema().Attributes,
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the download client without testing it first, as Prowlarr `forceSave` does. Otherwise enabled download clients are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -181,6 +194,9 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"destination": schema.StringAttribute{
 				MarkdownDescription: "Destination.",
@@ -207,9 +223,14 @@ func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *DownloadClientFloodResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientFlood
@@ -222,14 +243,30 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientFloodResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, downloadClientFloodResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -247,13 +284,15 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFloodResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
@@ -272,14 +311,30 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientFloodResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, downloadClientFloodResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -308,7 +363,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, downloadClientFloodResourceName, path.Root("id"), req, resp, downloadClientImportLookup(r.auth, r.client, downloadClientFloodImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
 
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientFreeboxResource{}
 	_ resource.ResourceWithImportState = &DownloadClientFreeboxResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientFreeboxResource{}
 )
 
 func NewDownloadClientFreeboxResource() resource.Resource {
@@ -37,13 +38,15 @@ func NewDownloadClientFreeboxResource() resource.Resource {
 
 // DownloadClientFreeboxResource defines the download client implementation.
 type DownloadClientFreeboxResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // DownloadClientFreebox describes the download client data model.
 type DownloadClientFreebox struct {
 	Tags                 types.Set    `tfsdk:"tags"`
+	TagsAll              types.Set    `tfsdk:"tags_all"`
 	Categories           types.Set    `tfsdk:"categories"`
 	Name                 types.String `tfsdk:"name"`
 	Host                 types.String `tfsdk:"host"`
@@ -59,6 +62,7 @@ type DownloadClientFreebox struct {
 	AddPaused            types.Bool   `tfsdk:"add_paused"`
 	UseSsl               types.Bool   `tfsdk:"use_ssl"`
 	Enable               types.Bool   `tfsdk:"enable"`
+	ForceSave            types.Bool   `tfsdk:"force_save"`
 }
 
 func (d DownloadClientFreebox) toDownloadClient() *DownloadClient {
@@ -132,6 +136,11 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"categories": schema.SetNestedAttribute{
 				MarkdownDescription: "List of mapped categories.",
 				Computed:            true,
@@ -139,6 +148,10 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 					Attributes: DownloadClientResource{}.getClientCategorySchema().Attributes,
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the download client without testing it first, as Prowlarr `forceSave` does. Otherwise enabled download clients are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -185,6 +198,9 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 				MarkdownDescription: "App Token.",
 				Required:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"category": schema.StringAttribute{
 				MarkdownDescription: "category.",
@@ -204,9 +220,14 @@ func (r *DownloadClientFreeboxResource) Configure(ctx context.Context, req resou
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *DownloadClientFreeboxResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientFreebox
@@ -219,14 +240,30 @@ func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource
 
 	// Create new DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientFreeboxResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFreeboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, downloadClientFreeboxResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientFreeboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -244,13 +281,15 @@ func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get DownloadClientFreebox current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFreeboxResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFreeboxResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+downloadClientFreeboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
@@ -269,14 +308,30 @@ func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource
 
 	// Update DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientFreeboxResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFreeboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, downloadClientFreeboxResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+downloadClientFreeboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -305,7 +360,7 @@ func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource
 }
 
 func (r *DownloadClientFreeboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, downloadClientFreeboxResourceName, path.Root("id"), req, resp, downloadClientImportLookup(r.auth, r.client, downloadClientFreeboxImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientFreeboxResourceName+": "+req.ID)
 }
 
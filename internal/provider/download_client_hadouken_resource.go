@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientHadoukenResource{}
 	_ resource.ResourceWithImportState = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientHadoukenResource{}
 )
 
 func NewDownloadClientHadoukenResource() resource.Resource {
@@ -35,13 +36,15 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
 	Tags       types.Set    `tfsdk:"tags"`
+	TagsAll    types.Set    `tfsdk:"tags_all"`
 	Categories types.Set    `tfsdk:"categories"`
 	Name       types.String `tfsdk:"name"`
 	Host       types.String `tfsdk:"host"`
@@ -54,6 +57,7 @@ type DownloadClientHadouken struct {
 	ID         types.Int64  `tfsdk:"id"`
 	UseSsl     types.Bool   `tfsdk:"use_ssl"`
 	Enable     types.Bool   `tfsdk:"enable"`
+	ForceSave  types.Bool   `tfsdk:"force_save"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
@@ -121,6 +125,11 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"categories": schema.SetNestedAttribute{
 				MarkdownDescription: "List of mapped categories.",
 				Computed:            true,
@@ -128,6 +137,10 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 					Attributes: DownloadClientResource{}.getClientCategorySchema().Attributes,
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the download client without testing it first, as Prowlarr `forceSave` does. Otherwise enabled download clients are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -164,6 +177,9 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Password.",
 				Required:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"category": schema.StringAttribute{
 				MarkdownDescription: "Category.",
@@ -178,9 +194,14 @@ func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req reso
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *DownloadClientHadoukenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientHadouken
@@ -193,14 +214,30 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientHadoukenResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, downloadClientHadoukenResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -218,13 +255,15 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientHadoukenResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
@@ -243,14 +282,30 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientHadoukenResourceName, err)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, downloadClientHadoukenResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
@@ -279,7 +334,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, downloadClientHadoukenResourceName, path.Root("id"), req, resp, downloadClientImportLookup(r.auth, r.client, downloadClientHadoukenImplementation))
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
 
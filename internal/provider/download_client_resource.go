@@ -27,6 +27,7 @@ const downloadClientResourceName = "download_client"
 var (
 	_ resource.Resource                = &DownloadClientResource{}
 	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientResource{}
 )
 
 var downloadClientFields = helpers.Fields{
@@ -45,8 +46,9 @@ func NewDownloadClientResource() resource.Resource {
 
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // DownloadClient describes the download client data model.
@@ -95,6 +97,14 @@ type DownloadClient struct {
 	Enable               types.Bool   `tfsdk:"enable"`
 }
 
+// DownloadClientResourceData adds the resource only attributes to the download client data model,
+// which is shared with the data sources.
+type DownloadClientResourceData struct {
+	DownloadClient
+	TagsAll   types.Set  `tfsdk:"tags_all"`
+	ForceSave types.Bool `tfsdk:"force_save"`
+}
+
 func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -200,6 +210,11 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"categories": schema.SetNestedAttribute{
 				MarkdownDescription: "List of mapped categories.",
 				Optional:            true,
@@ -208,6 +223,10 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 					Attributes: r.getClientCategorySchema().Attributes,
 				},
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the download client without testing it first, as Prowlarr `forceSave` does. Otherwise enabled download clients are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -282,6 +301,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"rpc_path": schema.StringAttribute{
 				MarkdownDescription: "RPC path.",
@@ -308,12 +330,18 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"secret_token": schema.StringAttribute{
 				MarkdownDescription: "Secret token.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Username.",
@@ -325,6 +353,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"tv_imported_category": schema.StringAttribute{
 				MarkdownDescription: "TV imported category.",
@@ -421,12 +452,17 @@ func (r *DownloadClientResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *DownloadClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *DownloadClient
+	var client *DownloadClientResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -436,27 +472,43 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientResourceName, err)
+
+			return
+		}
+	}
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, downloadClientResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	state := DownloadClientResourceData{ForceSave: client.ForceSave}
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client *DownloadClient
+	var client *DownloadClientResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -465,26 +517,28 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get DownloadClient current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	state := DownloadClientResourceData{ForceSave: client.ForceSave}
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var client *DownloadClient
+	var client *DownloadClientResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -494,20 +548,36 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !client.ForceSave.ValueBool() && downloadClientEnabled(request) {
+		if _, err := r.client.DownloadClientAPI.TestDownloadClient(r.auth).DownloadClientResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, downloadClientResourceName, err)
+
+			return
+		}
+	}
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(client.ForceSave.ValueBool()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, downloadClientResourceName, err)
 
 		return
 	}
 
+	response.Tags, client.TagsAll = r.defaultTags.split(ctx, response.Tags, client.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	state := DownloadClientResourceData{ForceSave: client.ForceSave}
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -534,10 +604,28 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, downloadClientResourceName, path.Root("id"), req, resp, downloadClientImportLookup(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
 }
 
+// downloadClientImportLookup resolves download clients by `name:<name>` on import.
+// Typed resources pass their implementation, so that other implementations are rejected.
+func downloadClientImportLookup(auth context.Context, client *prowlarr.APIClient, implementation string) helpers.ImportLookup[prowlarr.DownloadClientResource] {
+	return helpers.ImportLookup[prowlarr.DownloadClientResource]{
+		Keys: map[string]func(*prowlarr.DownloadClientResource) string{
+			"name": (*prowlarr.DownloadClientResource).GetName,
+		},
+		List: func() ([]prowlarr.DownloadClientResource, error) {
+			response, _, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+
+			return response, err
+		},
+		ID:             (*prowlarr.DownloadClientResource).GetId,
+		Implementation: (*prowlarr.DownloadClientResource).GetImplementation,
+		Expected:       implementation,
+	}
+}
+
 func (d *DownloadClient) write(ctx context.Context, downloadClient *prowlarr.DownloadClientResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -623,3 +711,8 @@ func (d *DownloadClient) writeSensitive(client *DownloadClient) {
 		d.AppToken = client.AppToken
 	}
 }
+
+// downloadClientEnabled mirrors Prowlarr, which tests only enabled download clients on save.
+func downloadClientEnabled(client *prowlarr.DownloadClientResource) bool {
+	return client.GetEnable()
+}
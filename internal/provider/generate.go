@@ -0,0 +1,491 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const generateHeader = `# Generated by terraform-provider-prowlarr generate.
+# Sensitive values cannot be read from Prowlarr and are set to "` + helpers.SensitiveValue + `",
+# which keeps the stored value: replace them with the real secrets to manage them.
+`
+
+var (
+	errGenerate      = errors.New("unable to generate configuration")
+	invalidLabelChar = regexp.MustCompile(`[^a-z0-9_]+`)
+)
+
+// generator defines how to generate the configuration of a specific resource.
+type generator[T any] struct {
+	resource func() resource.Resource
+	model    func(context.Context, *T, *diag.Diagnostics) any
+}
+
+type modelWriter[T any, E any] interface {
+	*E
+	write(context.Context, *T, *diag.Diagnostics)
+}
+
+// newModel returns the resource model populated with the API resource.
+func newModel[T any, E any, M modelWriter[T, E]](ctx context.Context, item *T, diags *diag.Diagnostics) any {
+	model := M(new(E))
+	model.write(ctx, item, diags)
+
+	// Computed only attributes not written by the model, such as tags_all, must still be typed.
+	if field := reflect.ValueOf(model).Elem().FieldByName("TagsAll"); field.IsValid() {
+		field.Set(reflect.ValueOf(types.SetNull(types.Int64Type)))
+	}
+
+	return model
+}
+
+// applicationGenerators maps the implementations to the typed resources.
+var applicationGenerators = map[string]generator[prowlarr.ApplicationResource]{
+	applicationLazyLibrarianImplementation: {NewApplicationLazyLibrarianResource, newModel[prowlarr.ApplicationResource, ApplicationLazyLibrarian]},
+	applicationLidarrImplementation:        {NewApplicationLidarrResource, newModel[prowlarr.ApplicationResource, ApplicationLidarr]},
+	applicationMylarImplementation:         {NewApplicationMylarResource, newModel[prowlarr.ApplicationResource, ApplicationMylar]},
+	applicationRadarrImplementation:        {NewApplicationRadarrResource, newModel[prowlarr.ApplicationResource, ApplicationRadarr]},
+	applicationReadarrImplementation:       {NewApplicationReadarrResource, newModel[prowlarr.ApplicationResource, ApplicationReadarr]},
+	applicationSonarrImplementation:        {NewApplicationSonarrResource, newModel[prowlarr.ApplicationResource, ApplicationSonarr]},
+	applicationWhisparrImplementation:      {NewApplicationWhisparrResource, newModel[prowlarr.ApplicationResource, ApplicationWhisparr]},
+}
+
+// downloadClientGenerators maps the implementations to the typed resources.
+var downloadClientGenerators = map[string]generator[prowlarr.DownloadClientResource]{
+	downloadClientAria2Implementation:                  {NewDownloadClientAria2Resource, newModel[prowlarr.DownloadClientResource, DownloadClientAria2]},
+	downloadClientDelugeImplementation:                 {NewDownloadClientDelugeResource, newModel[prowlarr.DownloadClientResource, DownloadClientDeluge]},
+	downloadClientFloodImplementation:                  {NewDownloadClientFloodResource, newModel[prowlarr.DownloadClientResource, DownloadClientFlood]},
+	downloadClientFreeboxImplementation:                {NewDownloadClientFreeboxResource, newModel[prowlarr.DownloadClientResource, DownloadClientFreebox]},
+	downloadClientHadoukenImplementation:               {NewDownloadClientHadoukenResource, newModel[prowlarr.DownloadClientResource, DownloadClientHadouken]},
+	downloadClientNzbgetImplementation:                 {NewDownloadClientNzbgetResource, newModel[prowlarr.DownloadClientResource, DownloadClientNzbget]},
+	downloadClientNzbvortexImplementation:              {NewDownloadClientNzbvortexResource, newModel[prowlarr.DownloadClientResource, DownloadClientNzbvortex]},
+	downloadClientPneumaticImplementation:              {NewDownloadClientPneumaticResource, newModel[prowlarr.DownloadClientResource, DownloadClientPneumatic]},
+	downloadClientQbittorrentImplementation:            {NewDownloadClientQbittorrentResource, newModel[prowlarr.DownloadClientResource, DownloadClientQbittorrent]},
+	downloadClientRtorrentImplementation:               {NewDownloadClientRtorrentResource, newModel[prowlarr.DownloadClientResource, DownloadClientRtorrent]},
+	downloadClientSabnzbdImplementation:                {NewDownloadClientSabnzbdResource, newModel[prowlarr.DownloadClientResource, DownloadClientSabnzbd]},
+	downloadClientTorrentBlackholeImplementation:       {NewDownloadClientTorrentBlackholeResource, newModel[prowlarr.DownloadClientResource, DownloadClientTorrentBlackhole]},
+	downloadClientTorrentDownloadStationImplementation: {NewDownloadClientTorrentDownloadStationResource, newModel[prowlarr.DownloadClientResource, DownloadClientTorrentDownloadStation]},
+	downloadClientTransmissionImplementation:           {NewDownloadClientTransmissionResource, newModel[prowlarr.DownloadClientResource, DownloadClientTransmission]},
+	downloadClientUsenetBlackholeImplementation:        {NewDownloadClientUsenetBlackholeResource, newModel[prowlarr.DownloadClientResource, DownloadClientUsenetBlackhole]},
+	downloadClientUsenetDownloadStationImplementation:  {NewDownloadClientUsenetDownloadStationResource, newModel[prowlarr.DownloadClientResource, DownloadClientUsenetDownloadStation]},
+	downloadClientUtorrentImplementation:               {NewDownloadClientUtorrentResource, newModel[prowlarr.DownloadClientResource, DownloadClientUtorrent]},
+	downloadClientVuzeImplementation:                   {NewDownloadClientVuzeResource, newModel[prowlarr.DownloadClientResource, DownloadClientVuze]},
+}
+
+// indexerProxyGenerators maps the implementations to the typed resources.
+var indexerProxyGenerators = map[string]generator[prowlarr.IndexerProxyResource]{
+	indexerProxyFlaresolverrImplementation: {NewIndexerProxyFlaresolverrResource, newModel[prowlarr.IndexerProxyResource, IndexerProxyFlaresolverr]},
+	indexerProxyHTTPImplementation:         {NewIndexerProxyHTTPResource, newModel[prowlarr.IndexerProxyResource, IndexerProxyHTTP]},
+	indexerProxySocks4Implementation:       {NewIndexerProxySocks4Resource, newModel[prowlarr.IndexerProxyResource, IndexerProxySocks4]},
+	indexerProxySocks5Implementation:       {NewIndexerProxySocks5Resource, newModel[prowlarr.IndexerProxyResource, IndexerProxySocks5]},
+}
+
+// notificationGenerators maps the implementations to the typed resources.
+var notificationGenerators = map[string]generator[prowlarr.NotificationResource]{
+	notificationAppriseImplementation:      {NewNotificationAppriseResource, newModel[prowlarr.NotificationResource, NotificationApprise]},
+	notificationCustomScriptImplementation: {NewNotificationCustomScriptResource, newModel[prowlarr.NotificationResource, NotificationCustomScript]},
+	notificationDiscordImplementation:      {NewNotificationDiscordResource, newModel[prowlarr.NotificationResource, NotificationDiscord]},
+	notificationEmailImplementation:        {NewNotificationEmailResource, newModel[prowlarr.NotificationResource, NotificationEmail]},
+	notificationGotifyImplementation:       {NewNotificationGotifyResource, newModel[prowlarr.NotificationResource, NotificationGotify]},
+	notificationJoinImplementation:         {NewNotificationJoinResource, newModel[prowlarr.NotificationResource, NotificationJoin]},
+	notificationMailgunImplementation:      {NewNotificationMailgunResource, newModel[prowlarr.NotificationResource, NotificationMailgun]},
+	notificationNotifiarrImplementation:    {NewNotificationNotifiarrResource, newModel[prowlarr.NotificationResource, NotificationNotifiarr]},
+	notificationNtfyImplementation:         {NewNotificationNtfyResource, newModel[prowlarr.NotificationResource, NotificationNtfy]},
+	notificationProwlImplementation:        {NewNotificationProwlResource, newModel[prowlarr.NotificationResource, NotificationProwl]},
+	notificationPushbulletImplementation:   {NewNotificationPushbulletResource, newModel[prowlarr.NotificationResource, NotificationPushbullet]},
+	notificationPushoverImplementation:     {NewNotificationPushoverResource, newModel[prowlarr.NotificationResource, NotificationPushover]},
+	notificationSendgridImplementation:     {NewNotificationSendgridResource, newModel[prowlarr.NotificationResource, NotificationSendgrid]},
+	notificationSignalImplementation:       {NewNotificationSignalResource, newModel[prowlarr.NotificationResource, NotificationSignal]},
+	notificationSimplepushImplementation:   {NewNotificationSimplepushResource, newModel[prowlarr.NotificationResource, NotificationSimplepush]},
+	notificationSlackImplementation:        {NewNotificationSlackResource, newModel[prowlarr.NotificationResource, NotificationSlack]},
+	notificationTelegramImplementation:     {NewNotificationTelegramResource, newModel[prowlarr.NotificationResource, NotificationTelegram]},
+	notificationTwitterImplementation:      {NewNotificationTwitterResource, newModel[prowlarr.NotificationResource, NotificationTwitter]},
+	notificationWebhookImplementation:      {NewNotificationWebhookResource, newModel[prowlarr.NotificationResource, NotificationWebhook]},
+}
+
+// indexerGenerators maps the implementations to the typed resources.
+var indexerGenerators = map[string]generator[prowlarr.IndexerResource]{
+	indexerCardigannImplementation: {NewIndexerCardigannResource, newModel[prowlarr.IndexerResource, IndexerCardigann]},
+	indexerNewznabImplementation:   {NewIndexerNewznabResource, newModel[prowlarr.IndexerResource, IndexerNewznab]},
+	indexerTorznabImplementation:   {NewIndexerTorznabResource, newModel[prowlarr.IndexerResource, IndexerTorznab]},
+}
+
+// configGenerator writes resources and import blocks for an existing instance.
+type configGenerator struct {
+	auth   context.Context
+	client *prowlarr.APIClient
+	file   *hclwrite.File
+	labels map[string]int
+}
+
+// Generate writes the configuration of all the resources of a Prowlarr instance,
+// together with the import blocks needed to bring them under management.
+// The API key is resolved as in the provider, from PROWLARR_API_KEY, then the API key file and then config.xml.
+// Empty URL and paths, as well as all the other connection settings (TLS, proxy, basic auth, ...),
+// are read from the same environment variables as the provider.
+func Generate(ctx context.Context, w io.Writer, apiURL, apiKeyFile, configXMLPath string) error {
+	var diags diag.Diagnostics
+
+	parsedAPIURL, key, config := getAPIConfig(ctx, Prowlarr{
+		URL:           types.StringValue(apiURL),
+		APIKey:        types.StringNull(),
+		APIKeyFile:    types.StringValue(apiKeyFile),
+		ConfigXMLPath: types.StringValue(configXMLPath),
+	}, &diags)
+	if diags.HasError() {
+		messages := make([]string, 0, diags.ErrorsCount())
+		for _, d := range diags.Errors() {
+			messages = append(messages, d.Summary()+": "+d.Detail())
+		}
+
+		return fmt.Errorf("%w: %s", errGenerate, strings.Join(messages, "; "))
+	}
+
+	g := &configGenerator{
+		auth:   newAuthContext(parsedAPIURL, key),
+		client: prowlarr.NewAPIClient(config),
+		file:   hclwrite.NewEmptyFile(),
+		labels: make(map[string]int),
+	}
+
+	g.file.Body().AppendUnstructuredTokens(hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte(generateHeader)}})
+
+	for _, step := range []func(context.Context) error{
+		g.generateTags,
+		g.generateSyncProfiles,
+		g.generateIndexerProxies,
+		g.generateDownloadClients,
+		g.generateApplications,
+		g.generateNotifications,
+		g.generateIndexers,
+	} {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+
+	_, err := g.file.WriteTo(w)
+
+	return err
+}
+
+func (g *configGenerator) generateTags(ctx context.Context) error {
+	tags, _, err := g.client.TagAPI.ListTag(g.auth).Execute()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGenerate, helpers.ParseClientError(helpers.List, tagResourceName, err))
+	}
+
+	tagGenerator := generator[prowlarr.TagResource]{
+		resource: NewTagResource,
+		model: func(_ context.Context, tag *prowlarr.TagResource, _ *diag.Diagnostics) any {
+			model := &Tag{}
+			model.write(tag)
+
+			return model
+		},
+	}
+
+	for i := range tags {
+		if err := generateResource(ctx, g, tagGenerator, &tags[i], tags[i].GetLabel(), tags[i].GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) generateSyncProfiles(ctx context.Context) error {
+	profiles, _, err := g.client.AppProfileAPI.ListAppProfile(g.auth).Execute()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGenerate, helpers.ParseClientError(helpers.List, syncProfileResourceName, err))
+	}
+
+	profileGenerator := generator[prowlarr.AppProfileResource]{
+		resource: NewSyncProfileResource,
+		model: func(_ context.Context, profile *prowlarr.AppProfileResource, _ *diag.Diagnostics) any {
+			model := &SyncProfile{}
+			model.write(profile)
+
+			return model
+		},
+	}
+
+	for i := range profiles {
+		if err := generateResource(ctx, g, profileGenerator, &profiles[i], profiles[i].GetName(), profiles[i].GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) generateIndexerProxies(ctx context.Context) error {
+	proxies, _, err := g.client.IndexerProxyAPI.ListIndexerProxy(g.auth).Execute()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGenerate, helpers.ParseClientError(helpers.List, indexerProxyResourceName, err))
+	}
+
+	for i := range proxies {
+		proxyGenerator, ok := indexerProxyGenerators[proxies[i].GetImplementation()]
+		if !ok {
+			proxyGenerator = generator[prowlarr.IndexerProxyResource]{NewIndexerProxyResource, newModel[prowlarr.IndexerProxyResource, IndexerProxy]}
+		}
+
+		if err := generateResource(ctx, g, proxyGenerator, &proxies[i], proxies[i].GetName(), proxies[i].GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) generateDownloadClients(ctx context.Context) error {
+	clients, _, err := g.client.DownloadClientAPI.ListDownloadClient(g.auth).Execute()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGenerate, helpers.ParseClientError(helpers.List, downloadClientResourceName, err))
+	}
+
+	for i := range clients {
+		clientGenerator, ok := downloadClientGenerators[clients[i].GetImplementation()]
+		if !ok {
+			clientGenerator = generator[prowlarr.DownloadClientResource]{NewDownloadClientResource, newModel[prowlarr.DownloadClientResource, DownloadClientResourceData]}
+		}
+
+		if err := generateResource(ctx, g, clientGenerator, &clients[i], clients[i].GetName(), clients[i].GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) generateApplications(ctx context.Context) error {
+	applications, _, err := g.client.ApplicationAPI.ListApplications(g.auth).Execute()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGenerate, helpers.ParseClientError(helpers.List, applicationResourceName, err))
+	}
+
+	for i := range applications {
+		applicationGenerator, ok := applicationGenerators[applications[i].GetImplementation()]
+		if !ok {
+			applicationGenerator = generator[prowlarr.ApplicationResource]{NewApplicationResource, newModel[prowlarr.ApplicationResource, ApplicationResourceData]}
+		}
+
+		if err := generateResource(ctx, g, applicationGenerator, &applications[i], applications[i].GetName(), applications[i].GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) generateNotifications(ctx context.Context) error {
+	notifications, _, err := g.client.NotificationAPI.ListNotification(g.auth).Execute()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGenerate, helpers.ParseClientError(helpers.List, notificationResourceName, err))
+	}
+
+	for i := range notifications {
+		notificationGenerator, ok := notificationGenerators[notifications[i].GetImplementation()]
+		if !ok {
+			notificationGenerator = generator[prowlarr.NotificationResource]{NewNotificationResource, newModel[prowlarr.NotificationResource, NotificationResourceData]}
+		}
+
+		if err := generateResource(ctx, g, notificationGenerator, &notifications[i], notifications[i].GetName(), notifications[i].GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) generateIndexers(ctx context.Context) error {
+	indexers, _, err := g.client.IndexerAPI.ListIndexer(g.auth).Execute()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGenerate, helpers.ParseClientError(helpers.List, indexerResourceName, err))
+	}
+
+	for i := range indexers {
+		indexerGenerator, ok := indexerGenerators[indexers[i].GetImplementation()]
+		if !ok {
+			indexerGenerator = generator[prowlarr.IndexerResource]{NewIndexerResource, newModel[prowlarr.IndexerResource, IndexerResourceData]}
+		}
+
+		if err := generateResource(ctx, g, indexerGenerator, &indexers[i], indexers[i].GetName(), indexers[i].GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateResource appends the resource and its import block to the file.
+func generateResource[T any](ctx context.Context, g *configGenerator, gen generator[T], item *T, name string, id int32) error {
+	var diags diag.Diagnostics
+
+	r := gen.resource()
+
+	metadata := resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "prowlarr"}, &metadata)
+
+	schema := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+
+	state := tfsdk.State{
+		Schema: schema.Schema,
+		Raw:    tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	model := gen.model(ctx, item, &diags)
+	diags.Append(state.Set(ctx, model)...)
+
+	values := make(map[string]tftypes.Value)
+	if err := state.Raw.As(&values); err != nil {
+		diags.AddError(helpers.ResourceError, err.Error())
+	}
+
+	if diags.HasError() {
+		return fmt.Errorf("%w for %s '%s': %s", errGenerate, metadata.TypeName, name, diags.Errors()[0].Detail())
+	}
+
+	label := g.label(metadata.TypeName, name)
+	body := g.file.Body()
+	body.AppendNewline()
+
+	block := body.AppendNewBlock("resource", []string{metadata.TypeName, label}).Body()
+
+	attributes := make([]string, 0, len(schema.Schema.Attributes))
+	for attribute := range schema.Schema.Attributes {
+		attributes = append(attributes, attribute)
+	}
+
+	slices.Sort(attributes)
+
+	for _, attribute := range attributes {
+		definition := schema.Schema.Attributes[attribute]
+		// Computed only attributes cannot be configured.
+		if attribute == "id" || (!definition.IsRequired() && !definition.IsOptional()) || values[attribute].IsNull() {
+			continue
+		}
+
+		value, err := ctyValue(values[attribute])
+		if err != nil {
+			return fmt.Errorf("%w for %s '%s': %w", errGenerate, metadata.TypeName, name, err)
+		}
+
+		block.SetAttributeValue(attribute, value)
+	}
+
+	body.AppendNewline()
+
+	imported := body.AppendNewBlock("import", nil).Body()
+	imported.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: metadata.TypeName}, hcl.TraverseAttr{Name: label}})
+	imported.SetAttributeValue("id", cty.StringVal(strconv.Itoa(int(id))))
+
+	return nil
+}
+
+// label returns a unique resource label starting from the resource name.
+func (g *configGenerator) label(typeName, name string) string {
+	label := strings.Trim(invalidLabelChar.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "_" + label
+	}
+
+	key := typeName + "." + label
+	g.labels[key]++
+
+	if count := g.labels[key]; count > 1 {
+		label = fmt.Sprintf("%s_%d", label, count)
+	}
+
+	return label
+}
+
+// ctyValue converts a terraform value into its HCL representation.
+// Collections are converted to tuples and objects, dropping null attributes.
+func ctyValue(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+
+		return cty.StringVal(s), err
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		err := value.As(&n)
+
+		return cty.NumberVal(n), err
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+
+		return cty.BoolVal(b), err
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+
+		output := make([]cty.Value, 0, len(elements))
+
+		for _, e := range elements {
+			element, err := ctyValue(e)
+			if err != nil {
+				return cty.NilVal, err
+			}
+
+			output = append(output, element)
+		}
+
+		return cty.TupleVal(output), nil
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+
+		output := make(map[string]cty.Value, len(elements))
+
+		for k, e := range elements {
+			if e.IsNull() {
+				continue
+			}
+
+			element, err := ctyValue(e)
+			if err != nil {
+				return cty.NilVal, err
+			}
+
+			output[k] = element
+		}
+
+		return cty.ObjectVal(output), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("%w: unsupported type %s", errGenerate, value.Type())
+}
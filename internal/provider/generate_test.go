@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	responses := map[string]string{
+		"/api/v1/tag":        `[{"id":1,"label":"torrent"}]`,
+		"/api/v1/appprofile": `[{"id":1,"name":"Standard","enableRss":true,"enableInteractiveSearch":true,"enableAutomaticSearch":true,"minimumSeeders":1}]`,
+		"/api/v1/indexerproxy": `[{"id":2,"name":"Flare","implementation":"Flaresolverr","configContract":"FlareSolverrSettings","tags":[1],
+			"fields":[{"name":"host","value":"http://localhost:8191/"},{"name":"requestTimeout","value":60}]}]`,
+		"/api/v1/downloadclient": `[]`,
+		"/api/v1/applications": `[{"id":3,"name":"Sonarr","implementation":"Sonarr","configContract":"SonarrSettings","syncLevel":"fullSync","tags":[],
+			"fields":[{"name":"prowlarrUrl","value":"http://localhost:9696"},{"name":"baseUrl","value":"http://localhost:8989"},{"name":"apiKey","value":"********"},{"name":"syncCategories","value":[5000]}]},
+			{"id":4,"name":"Sonarr","implementation":"Custom","configContract":"CustomSettings","syncLevel":"disabled","tags":[],"fields":[]}]`,
+		"/api/v1/notification": `[]`,
+		"/api/v1/indexer": `[{"id":5,"name":"1337x","implementation":"Cardigann","configContract":"CardigannSettings","definitionName":"1337x","protocol":"torrent","enable":true,"priority":25,"appProfileId":1,"tags":[],
+			"fields":[{"name":"definitionFile","value":"1337x"},{"name":"baseUrl","value":"https://1337x.to/"},{"name":"sort","value":2,"type":"select"}]}]`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	keyFile := filepath.Join(t.TempDir(), "api_key")
+	assert.NoError(t, os.WriteFile(keyFile, []byte("key\n"), 0o600))
+
+	var output bytes.Buffer
+
+	err := Generate(context.Background(), &output, server.URL, keyFile, "")
+	assert.NoError(t, err)
+
+	config := output.String()
+	for _, expected := range []string{
+		`resource "prowlarr_tag" "torrent" {`,
+		`resource "prowlarr_sync_profile" "standard" {`,
+		`resource "prowlarr_indexer_proxy_flaresolverr" "flare" {`,
+		`resource "prowlarr_application_sonarr" "sonarr" {`,
+		`resource "prowlarr_application" "sonarr" {`,
+		`resource "prowlarr_indexer_cardigann" "_1337x" {`,
+		`to = prowlarr_indexer_cardigann._1337x`,
+		`id = "5"`,
+		`api_key`,
+	} {
+		assert.Contains(t, config, expected)
+	}
+}
+
+func TestGenerateEnvironment(t *testing.T) {
+	t.Setenv("PROWLARR_API_KEY", "envKey")
+	t.Setenv("PROWLARR_BASIC_AUTH_USERNAME", "user")
+	t.Setenv("PROWLARR_BASIC_AUTH_PASSWORD", "pass")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "pass" || r.Header.Get("X-Api-Key") != "envKey" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	assert.NoError(t, Generate(context.Background(), &output, server.URL+"/", "", ""))
+}
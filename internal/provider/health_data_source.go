@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const healthDataSourceName = "health"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+// HealthDataSource defines the health implementation.
+type HealthDataSource struct {
+	client *prowlarr.APIClient
+	auth   context.Context
+}
+
+// Health describes the health data model.
+type Health struct {
+	Checks types.Set    `tfsdk:"checks"`
+	ID     types.String `tfsdk:"id"`
+}
+
+// HealthCheck describes the health check data model.
+type HealthCheck struct {
+	Source  types.String `tfsdk:"source"`
+	Type    types.String `tfsdk:"type"`
+	Message types.String `tfsdk:"message"`
+	WikiURL types.String `tfsdk:"wiki_url"`
+}
+
+func (h HealthCheck) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"source":   types.StringType,
+			"type":     types.StringType,
+			"message":  types.StringType,
+			"wiki_url": types.StringType,
+		})
+}
+
+func (d *HealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + healthDataSourceName
+}
+
+func (d *HealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList all failing health checks.\nFor more information refer to [Health](https://wiki.servarr.com/prowlarr/system#health) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"checks": schema.SetNestedAttribute{
+				MarkdownDescription: "Health check list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Health check source.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Health check result type. Valid values are: `ok`, `notice`, `warning`, `error`.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Health check message.",
+							Computed:            true,
+						},
+						"wiki_url": schema.StringAttribute{
+							MarkdownDescription: "Health check wiki URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get health current value
+	response, _, err := d.client.HealthAPI.ListHealth(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, healthDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+healthDataSourceName)
+	// Map response body to resource schema attribute
+	checks := make([]HealthCheck, len(response))
+	for i, h := range response {
+		checks[i].write(&h)
+	}
+
+	checkList, diags := types.SetValueFrom(ctx, HealthCheck{}.getType(), checks)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Health{Checks: checkList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (h *HealthCheck) write(health *prowlarr.HealthResource) {
+	h.Source = types.StringValue(health.GetSource())
+	h.Type = types.StringValue(string(health.GetType()))
+	h.Message = types.StringValue(health.GetMessage())
+	h.WikiURL = types.StringValue(health.GetWikiUrl())
+}
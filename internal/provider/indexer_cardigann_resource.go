@@ -0,0 +1,690 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	indexerCardigannResourceName   = "indexer_cardigann"
+	indexerCardigannImplementation = "Cardigann"
+	indexerCardigannConfigContract = "CardigannSettings"
+)
+
+var errInvalidSelectOption = errors.New("invalid select option")
+
+// indexerCardigannBaseFields are the fields managed by first-class attributes.
+var indexerCardigannBaseFields = []string{
+	"definitionFile",
+	"baseUrl",
+	"baseSettings.queryLimit",
+	"baseSettings.grabLimit",
+	"baseSettings.limitsUnit",
+	"torrentBaseSettings.appMinimumSeeders",
+	"torrentBaseSettings.seedRatio",
+	"torrentBaseSettings.seedTime",
+	"torrentBaseSettings.packSeedTime",
+	"torrentBaseSettings.preferMagnetUrl",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IndexerCardigannResource{}
+	_ resource.ResourceWithImportState = &IndexerCardigannResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerCardigannResource{}
+)
+
+func NewIndexerCardigannResource() resource.Resource {
+	return &IndexerCardigannResource{}
+}
+
+// IndexerCardigannResource defines the indexer implementation.
+type IndexerCardigannResource struct {
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
+	schemaCache *SchemaCache
+}
+
+// IndexerCardigann describes the indexer data model.
+type IndexerCardigann struct {
+	Tags              types.Set     `tfsdk:"tags"`
+	TagsAll           types.Set     `tfsdk:"tags_all"`
+	Settings          types.Map     `tfsdk:"settings"`
+	SensitiveSettings types.Map     `tfsdk:"sensitive_settings"`
+	DefinitionName    types.String  `tfsdk:"definition_name"`
+	Name              types.String  `tfsdk:"name"`
+	Protocol          types.String  `tfsdk:"protocol"`
+	Language          types.String  `tfsdk:"language"`
+	Privacy           types.String  `tfsdk:"privacy"`
+	BaseURL           types.String  `tfsdk:"base_url"`
+	SeedRatio         types.Float64 `tfsdk:"seed_ratio"`
+	AppProfileID      types.Int64   `tfsdk:"app_profile_id"`
+	Priority          types.Int64   `tfsdk:"priority"`
+	QueryLimit        types.Int64   `tfsdk:"query_limit"`
+	GrabLimit         types.Int64   `tfsdk:"grab_limit"`
+	LimitsUnit        types.Int64   `tfsdk:"limits_unit"`
+	MinimumSeeders    types.Int64   `tfsdk:"minimum_seeders"`
+	SeedTime          types.Int64   `tfsdk:"seed_time"`
+	PackSeedTime      types.Int64   `tfsdk:"pack_seed_time"`
+	ID                types.Int64   `tfsdk:"id"`
+	Enable            types.Bool    `tfsdk:"enable"`
+	PreferMagnetURL   types.Bool    `tfsdk:"prefer_magnet_url"`
+	ForceSave         types.Bool    `tfsdk:"force_save"`
+}
+
+func (i IndexerCardigann) toIndexer(ctx context.Context, diags *diag.Diagnostics) *Indexer {
+	indexer := Indexer{
+		Tags:           i.Tags,
+		Name:           i.Name,
+		Protocol:       i.Protocol,
+		Language:       i.Language,
+		Privacy:        i.Privacy,
+		AppProfileID:   i.AppProfileID,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		Enable:         i.Enable,
+		Implementation: types.StringValue(indexerCardigannImplementation),
+		ConfigContract: types.StringValue(indexerCardigannConfigContract),
+	}
+
+	indexer.setFields(ctx, []Field{
+		newTextField("definitionFile", i.DefinitionName),
+		newTextField("baseUrl", i.BaseURL),
+		newInt64Field("baseSettings.queryLimit", i.QueryLimit),
+		newInt64Field("baseSettings.grabLimit", i.GrabLimit),
+		newInt64Field("baseSettings.limitsUnit", i.LimitsUnit),
+		newInt64Field("torrentBaseSettings.appMinimumSeeders", i.MinimumSeeders),
+		newFloat64Field("torrentBaseSettings.seedRatio", i.SeedRatio),
+		newInt64Field("torrentBaseSettings.seedTime", i.SeedTime),
+		newInt64Field("torrentBaseSettings.packSeedTime", i.PackSeedTime),
+		newBoolField("torrentBaseSettings.preferMagnetUrl", i.PreferMagnetURL),
+	}, diags)
+
+	return &indexer
+}
+
+func (i *IndexerCardigann) fromIndexer(ctx context.Context, indexer *Indexer, diags *diag.Diagnostics) {
+	i.Tags = indexer.Tags
+	i.Name = indexer.Name
+	i.Protocol = indexer.Protocol
+	i.Language = indexer.Language
+	i.Privacy = indexer.Privacy
+	i.AppProfileID = indexer.AppProfileID
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Enable = indexer.Enable
+
+	fields := indexer.getFieldMap(ctx, diags)
+	i.DefinitionName = fields["definitionFile"].stringValue()
+	i.BaseURL = fields["baseUrl"].stringValue()
+	i.QueryLimit = fields["baseSettings.queryLimit"].int64Value()
+	i.GrabLimit = fields["baseSettings.grabLimit"].int64Value()
+	i.LimitsUnit = fields["baseSettings.limitsUnit"].int64Value()
+	i.MinimumSeeders = fields["torrentBaseSettings.appMinimumSeeders"].int64Value()
+	i.SeedRatio = fields["torrentBaseSettings.seedRatio"].float64Value()
+	i.SeedTime = fields["torrentBaseSettings.seedTime"].int64Value()
+	i.PackSeedTime = fields["torrentBaseSettings.packSeedTime"].int64Value()
+	i.PreferMagnetURL = fields["torrentBaseSettings.preferMagnetUrl"].BoolValue
+}
+
+func (r *IndexerCardigannResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerCardigannResourceName
+}
+
+func (r *IndexerCardigannResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Cardigann resource, keyed by definition.\nDefinition specific settings are validated at plan time and converted against the definition schema (see `prowlarr_indexer_schema` data source).\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Application profile ID.",
+				Required:            true,
+			},
+			"definition_name": schema.StringAttribute{
+				MarkdownDescription: "Definition name (e.g. `1337x`). Changing it replaces the indexer.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Indexer name.",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol.",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language.",
+				Computed:            true,
+			},
+			"privacy": schema.StringAttribute{
+				MarkdownDescription: "Privacy.",
+				Computed:            true,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the indexer without testing it first, as Prowlarr `forceSave` does. Otherwise enabled indexers are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"query_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of queries per limits unit.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"grab_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of grabs per limits unit.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"limits_unit": schema.Int64Attribute{
+				MarkdownDescription: "Limits unit. `0` Day, `1` Hour.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1),
+				},
+			},
+			"minimum_seeders": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of seeders required by the applications. Torrent only.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_ratio": schema.Float64Attribute{
+				MarkdownDescription: "Seed ratio. Torrent only.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Seed time in minutes. Torrent only.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pack_seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Season pack seed time in minutes. Torrent only.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"prefer_magnet_url": schema.BoolAttribute{
+				MarkdownDescription: "Prefer magnet URL flag. Torrent only.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "Definition specific settings, by field name. Checkbox values must be `true` or `false`, select values must be one of the option values and multiple values must be comma separated.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"sensitive_settings": schema.MapAttribute{
+				MarkdownDescription: "Definition specific sensitive settings (e.g. password, API key, cookie), by field name.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					helpers.ReplaceMaskedMap(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IndexerCardigannResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.schemaCache = resourceSchemaCache(req)
+	}
+}
+
+func (r *IndexerCardigannResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.defaultTags.modifyPlan(ctx, req, resp)
+
+	// Only validate against the live schema when the definition or settings changed.
+	if !req.State.Raw.IsNull() {
+		var state, plan *IndexerCardigann
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+		if resp.Diagnostics.HasError() || (plan.DefinitionName.Equal(state.DefinitionName) && plan.Settings.Equal(state.Settings) && plan.SensitiveSettings.Equal(state.SensitiveSettings)) {
+			return
+		}
+	}
+
+	var config *IndexerCardigann
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Settings cannot be validated until the definition and all of them are known.
+	if resp.Diagnostics.HasError() || config.DefinitionName.IsUnknown() || hasUnknownSettings(config.Settings) || hasUnknownSettings(config.SensitiveSettings) {
+		return
+	}
+
+	definition := r.getDefinition(ctx, config.DefinitionName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Report unknown settings and invalid values before applying.
+	config.readSettings(ctx, definition, &resp.Diagnostics)
+}
+
+func (r *IndexerCardigannResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var indexer *IndexerCardigann
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new IndexerCardigann
+	definition := r.getDefinition(ctx, indexer.DefinitionName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := indexer.read(ctx, definition, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !indexer.ForceSave.ValueBool() && indexerEnabled(request) {
+		if _, err := r.client.IndexerAPI.TestIndexer(r.auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, indexerCardigannResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).ForceSave(indexer.ForceSave.ValueBool()).IndexerResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, indexerCardigannResourceName, err)
+
+		return
+	}
+
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created "+indexerCardigannResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerCardigannResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var indexer *IndexerCardigann
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get IndexerCardigann current value
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, indexerCardigannResourceName, httpResp, err, resp)
+
+		return
+	}
+
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read "+indexerCardigannResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerCardigannResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var indexer *IndexerCardigann
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update IndexerCardigann
+	definition := r.getDefinition(ctx, indexer.DefinitionName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := indexer.read(ctx, definition, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !indexer.ForceSave.ValueBool() && indexerEnabled(request) {
+		if _, err := r.client.IndexerAPI.TestIndexer(r.auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, indexerCardigannResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(indexer.ForceSave.ValueBool()).IndexerResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, indexerCardigannResourceName, err)
+
+		return
+	}
+
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated "+indexerCardigannResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerCardigannResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete IndexerCardigann current value
+	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerCardigannResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+indexerCardigannResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IndexerCardigannResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateLookupIntID(ctx, indexerCardigannResourceName, path.Root("id"), req, resp, indexerImportLookup(r.auth, r.client, indexerCardigannImplementation))
+	tflog.Trace(ctx, "imported "+indexerCardigannResourceName+": "+req.ID)
+}
+
+// getDefinition retrieves the schema of the given definition.
+func (r *IndexerCardigannResource) getDefinition(ctx context.Context, name string, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	response, err := r.schemaCache.list(ctx, r.auth, r.client)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
+
+		return nil
+	}
+
+	definition := findIndexerDefinition(name, response)
+	if definition == nil || definition.GetImplementation() != indexerCardigannImplementation {
+		diags.AddAttributeError(path.Root("definition_name"), helpers.ResourceError, helpers.ParseNotFoundError("Cardigann definition", "name", name))
+	}
+
+	return definition
+}
+
+func (i *IndexerCardigann) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer(ctx, diags)
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(ctx, genericIndexer, diags)
+	i.writeSettings(ctx, indexer.GetFields(), diags)
+
+	if i.DefinitionName.IsNull() {
+		i.DefinitionName = types.StringValue(indexer.GetDefinitionName())
+	}
+}
+
+func (i *IndexerCardigann) read(ctx context.Context, definition *prowlarr.IndexerResource, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	genericIndexer := i.toIndexer(ctx, diags)
+	genericIndexer.Protocol = types.StringValue(string(definition.GetProtocol()))
+
+	indexer := genericIndexer.read(ctx, diags)
+	indexer.SetDefinitionName(definition.GetDefinitionName())
+	indexer.Fields = append(indexer.Fields, i.readSettings(ctx, definition, diags)...)
+
+	return indexer
+}
+
+// writeSettings populates settings and sensitive settings from the API fields.
+// When settings are already known, only the managed ones are kept.
+func (i *IndexerCardigann) writeSettings(ctx context.Context, fields []prowlarr.Field, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	managed := stringMapOrNil(ctx, i.Settings, diags)
+	sensitiveManaged := stringMapOrNil(ctx, i.SensitiveSettings, diags)
+	settings := make(map[string]string)
+	sensitiveSettings := make(map[string]string)
+
+	for _, f := range fields {
+		name := f.GetName()
+		if f.GetType() == "info" || f.GetValue() == nil || slices.Contains(indexerCardigannBaseFields, name) {
+			continue
+		}
+
+		value := formatSetting(f.GetValue())
+
+		if privacy := f.GetPrivacy(); privacy == prowlarr.PRIVACYLEVEL_PASSWORD || privacy == prowlarr.PRIVACYLEVEL_API_KEY || value == helpers.SensitiveValue {
+			prior, ok := sensitiveManaged[name]
+			// Masked values can only be taken from the prior state,
+			// otherwise they are kept masked (e.g. after import).
+			if value == helpers.SensitiveValue && ok {
+				value = prior
+			}
+
+			if ok || sensitiveManaged == nil {
+				sensitiveSettings[name] = value
+			}
+
+			continue
+		}
+
+		if _, ok := managed[name]; ok || managed == nil {
+			settings[name] = value
+		}
+	}
+
+	i.Settings, tempDiag = types.MapValueFrom(ctx, types.StringType, settings)
+	diags.Append(tempDiag...)
+	i.SensitiveSettings, tempDiag = types.MapValueFrom(ctx, types.StringType, sensitiveSettings)
+	diags.Append(tempDiag...)
+}
+
+// readSettings converts settings and sensitive settings to API fields, using the definition schema.
+func (i *IndexerCardigann) readSettings(ctx context.Context, definition *prowlarr.IndexerResource, diags *diag.Diagnostics) []prowlarr.Field {
+	schemaFields := make(map[string]prowlarr.Field, len(definition.GetFields()))
+	for _, f := range definition.GetFields() {
+		schemaFields[f.GetName()] = f
+	}
+
+	var fields []prowlarr.Field
+
+	for attribute, settings := range map[string]types.Map{"settings": i.Settings, "sensitive_settings": i.SensitiveSettings} {
+		for name, value := range stringMapOrNil(ctx, settings, diags) {
+			schemaField, ok := schemaFields[name]
+			if !ok || schemaField.GetType() == "info" || slices.Contains(indexerCardigannBaseFields, name) {
+				diags.AddAttributeError(path.Root(attribute).AtMapKey(name), helpers.ResourceError,
+					fmt.Sprintf("Unknown setting '%s' for definition '%s'", name, definition.GetDefinitionName()))
+
+				continue
+			}
+
+			fieldValue, err := parseSetting(&schemaField, value)
+			if err != nil {
+				diags.AddAttributeError(path.Root(attribute).AtMapKey(name), helpers.ResourceError,
+					fmt.Sprintf("Invalid value '%s' for setting '%s': %s", value, name, err))
+
+				continue
+			}
+
+			field := prowlarr.NewField()
+			field.SetName(name)
+			field.SetValue(fieldValue)
+			fields = append(fields, *field)
+		}
+	}
+
+	return fields
+}
+
+// hasUnknownSettings checks if the settings map, or any of its values, is unknown.
+func hasUnknownSettings(settings types.Map) bool {
+	if settings.IsUnknown() {
+		return true
+	}
+
+	for _, value := range settings.Elements() {
+		if value.IsUnknown() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringMapOrNil returns the map content or nil if the map is null or unknown.
+func stringMapOrNil(ctx context.Context, value types.Map, diags *diag.Diagnostics) map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	output := make(map[string]string, len(value.Elements()))
+	diags.Append(value.ElementsAs(ctx, &output, true)...)
+
+	return output
+}
+
+// formatSetting converts an API field value into its string representation.
+func formatSetting(value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		values := make([]string, len(v))
+		for n, element := range v {
+			values[n] = formatSetting(element)
+		}
+
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parseSetting converts a string setting into the API value expected by the schema field.
+func parseSetting(field *prowlarr.Field, value string) (interface{}, error) {
+	if _, ok := field.GetValue().([]interface{}); ok {
+		values := []interface{}{}
+
+		for _, element := range strings.Split(value, ",") {
+			if element = strings.TrimSpace(element); element == "" {
+				continue
+			}
+
+			parsed, err := parseSettingElement(field, element)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, parsed)
+		}
+
+		return values, nil
+	}
+
+	return parseSettingElement(field, value)
+}
+
+func parseSettingElement(field *prowlarr.Field, value string) (interface{}, error) {
+	switch field.GetType() {
+	case "checkbox":
+		return strconv.ParseBool(value)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "select":
+		options := field.GetSelectOptions()
+		if len(options) == 0 {
+			return value, nil
+		}
+
+		option, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || !isSelectOption(options, int32(option)) {
+			return nil, fmt.Errorf("%w, valid values are: %s", errInvalidSelectOption, formatSelectOptions(options))
+		}
+
+		return int32(option), nil
+	default:
+		return value, nil
+	}
+}
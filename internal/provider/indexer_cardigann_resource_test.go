@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerCardigannResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerCardigannResourceConfig("resourceCardigannTest", "https://0magnet.co/") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerCardigannResourceConfig("resourceCardigannTest", "https://0magnet.co/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "base_url", "https://0magnet.co/"),
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "protocol", "torrent"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_cardigann.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerCardigannResourceConfig("resourceCardigannTest", "https://0magnet.co/") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerCardigannResourceConfig("resourceCardigannTest", "https://13mag.net/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_cardigann.test", "base_url", "https://13mag.net/"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "prowlarr_indexer_cardigann.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIndexerCardigannResourceValidation(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown definition
+			{
+				Config:      testAccIndexerCardigannResourceValidationConfig("missingDefinition", `{}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Cardigann definition"),
+			},
+			// Unknown setting
+			{
+				Config:      testAccIndexerCardigannResourceValidationConfig("0magnet", `{ "missingSetting" = "value" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown setting 'missingSetting'"),
+			},
+			// Setting managed by an attribute
+			{
+				Config:      testAccIndexerCardigannResourceValidationConfig("0magnet", `{ "baseSettings.limitsUnit" = "0" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown setting 'baseSettings.limitsUnit'"),
+			},
+		},
+	})
+}
+
+func testAccIndexerCardigannResourceValidationConfig(definition, settings string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_cardigann" "test" {
+		enable = false
+		name = "validationCardigannTest"
+		definition_name = "%s"
+		app_profile_id = 1
+
+		settings = %s
+	}`, definition, settings)
+}
+
+func testAccIndexerCardigannResourceConfig(name, url string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_cardigann" "test" {
+		enable = false
+		name = "%s"
+		definition_name = "0magnet"
+		app_profile_id = 1
+
+		base_url = "%s"
+		query_limit = 2
+		limits_unit = 0
+		seed_ratio = 0.5
+		prefer_magnet_url = false
+	}`, name, url)
+}
@@ -25,6 +25,7 @@ func NewIndexerDataSource() datasource.DataSource {
 type IndexerDataSource struct {
 	client *prowlarr.APIClient
 	auth   context.Context
+	cache  *ListCache
 }
 
 func (d *IndexerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -112,6 +113,11 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"string_set_value": schema.SetAttribute{
+							MarkdownDescription: "String set value.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 					},
 				},
 			},
@@ -123,6 +129,7 @@ func (d *IndexerDataSource) Configure(ctx context.Context, req datasource.Config
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.cache = dataSourceListCache(req)
 	}
 }
 
@@ -136,7 +143,7 @@ func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
+	response, _, err := cachedList(d.cache, indexerListPath, d.client.IndexerAPI.ListIndexer(d.auth).Execute)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerDataSourceName, err))
 
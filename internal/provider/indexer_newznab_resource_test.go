@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerNewznabResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerNewznabResourceConfig("resourceNewznabTest", 10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerNewznabResourceConfig("resourceNewznabTest", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_newznab.test", "query_limit", "10"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_newznab.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerNewznabResourceConfig("resourceNewznabTest", 10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerNewznabResourceConfig("resourceNewznabTest", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_newznab.test", "query_limit", "20"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "prowlarr_indexer_newznab.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIndexerNewznabResourceForceSave(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Failing test on create
+			{
+				Config:      testAccIndexerNewznabResourceForceSaveConfig("resourceNewznabForceSaveTest", false),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create skipping the test
+			{
+				Config: testAccIndexerNewznabResourceForceSaveConfig("resourceNewznabForceSaveTest", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_newznab.test", "force_save", "true"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_newznab.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerNewznabResourceConfig(name string, limit int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_newznab" "test" {
+		enable = false
+		name = "%s"
+		app_profile_id = 1
+
+		base_url = "https://api.nzbgeek.info"
+		api_path = "/api"
+		api_key = "APIKey"
+		query_limit = %d
+		limits_unit = 0
+	}`, name, limit)
+}
+
+func testAccIndexerNewznabResourceForceSaveConfig(name string, force bool) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_newznab" "test" {
+		enable = true
+		force_save = %t
+		name = "%s"
+		app_profile_id = 1
+
+		base_url = "http://127.0.0.1:1"
+		api_path = "/api"
+		api_key = "APIKey"
+	}`, force, name)
+}
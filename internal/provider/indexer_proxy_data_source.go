@@ -26,6 +26,7 @@ func NewIndexerProxyDataSource() datasource.DataSource {
 type IndexerProxyDataSource struct {
 	client *prowlarr.APIClient
 	auth   context.Context
+	cache  *ListCache
 }
 
 func (d *IndexerProxyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -88,6 +89,7 @@ func (d *IndexerProxyDataSource) Configure(ctx context.Context, req datasource.C
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.cache = dataSourceListCache(req)
 	}
 }
 
@@ -100,7 +102,7 @@ func (d *IndexerProxyDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get indexerProxy current value
-	response, _, err := d.client.IndexerProxyAPI.ListIndexerProxy(d.auth).Execute()
+	response, _, err := cachedList(d.cache, indexerProxyListPath, d.client.IndexerProxyAPI.ListIndexerProxy(d.auth).Execute)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyDataSourceName, err))
 
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerProxyFlaresolverrResource{}
 	_ resource.ResourceWithImportState = &IndexerProxyFlaresolverrResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxyFlaresolverrResource{}
 )
 
 func NewIndexerProxyFlaresolverrResource() resource.Resource {
@@ -35,13 +36,15 @@ func NewIndexerProxyFlaresolverrResource() resource.Resource {
 
 // IndexerProxyFlaresolverrResource defines the indexer proxy implementation.
 type IndexerProxyFlaresolverrResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // IndexerProxyFlaresolverr describes the indexer proxy data model.
 type IndexerProxyFlaresolverr struct {
 	Tags           types.Set    `tfsdk:"tags"`
+	TagsAll        types.Set    `tfsdk:"tags_all"`
 	Name           types.String `tfsdk:"name"`
 	Host           types.String `tfsdk:"host"`
 	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
@@ -86,6 +89,11 @@ func (r *IndexerProxyFlaresolverrResource) Schema(_ context.Context, _ resource.
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer Proxy ID.",
 				Computed:            true,
@@ -110,9 +118,14 @@ func (r *IndexerProxyFlaresolverrResource) Configure(ctx context.Context, req re
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *IndexerProxyFlaresolverrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxyFlaresolverrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var proxy *IndexerProxyFlaresolverr
@@ -125,14 +138,21 @@ func (r *IndexerProxyFlaresolverrResource) Create(ctx context.Context, req resou
 
 	// Create new IndexerProxyFlaresolverr
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.CreateIndexerProxy(r.auth).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyFlaresolverrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, indexerProxyFlaresolverrResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerProxyFlaresolverrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -150,13 +170,15 @@ func (r *IndexerProxyFlaresolverrResource) Read(ctx context.Context, req resourc
 	}
 
 	// Get IndexerProxyFlaresolverr current value
-	response, _, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyFlaresolverrResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyFlaresolverrResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+indexerProxyFlaresolverrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -175,14 +197,21 @@ func (r *IndexerProxyFlaresolverrResource) Update(ctx context.Context, req resou
 
 	// Update IndexerProxyFlaresolverr
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.UpdateIndexerProxy(r.auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyFlaresolverrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, indexerProxyFlaresolverrResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+indexerProxyFlaresolverrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -211,7 +240,7 @@ func (r *IndexerProxyFlaresolverrResource) Delete(ctx context.Context, req resou
 }
 
 func (r *IndexerProxyFlaresolverrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, indexerProxyFlaresolverrResourceName, path.Root("id"), req, resp, indexerProxyImportLookup(r.auth, r.client, indexerProxyFlaresolverrImplementation))
 	tflog.Trace(ctx, "imported "+indexerProxyFlaresolverrResourceName+": "+req.ID)
 }
 
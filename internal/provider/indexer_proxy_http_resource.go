@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerProxyHTTPResource{}
 	_ resource.ResourceWithImportState = &IndexerProxyHTTPResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxyHTTPResource{}
 )
 
 func NewIndexerProxyHTTPResource() resource.Resource {
@@ -35,13 +36,15 @@ func NewIndexerProxyHTTPResource() resource.Resource {
 
 // IndexerProxyHTTPResource defines the indexer proxy implementation.
 type IndexerProxyHTTPResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // IndexerProxyHTTP describes the indexer proxy data model.
 type IndexerProxyHTTP struct {
 	Tags     types.Set    `tfsdk:"tags"`
+	TagsAll  types.Set    `tfsdk:"tags_all"`
 	Name     types.String `tfsdk:"name"`
 	Host     types.String `tfsdk:"host"`
 	Username types.String `tfsdk:"username"`
@@ -92,6 +95,11 @@ func (r *IndexerProxyHTTPResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer Proxy ID.",
 				Computed:            true,
@@ -116,6 +124,9 @@ func (r *IndexerProxyHTTPResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "Password.",
 				Required:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 		},
 	}
@@ -125,9 +136,14 @@ func (r *IndexerProxyHTTPResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *IndexerProxyHTTPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxyHTTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var proxy *IndexerProxyHTTP
@@ -140,14 +156,21 @@ func (r *IndexerProxyHTTPResource) Create(ctx context.Context, req resource.Crea
 
 	// Create new IndexerProxyHTTP
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.CreateIndexerProxy(r.auth).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyHTTPResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, indexerProxyHTTPResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerProxyHTTPResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -165,13 +188,15 @@ func (r *IndexerProxyHTTPResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get IndexerProxyHTTP current value
-	response, _, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyHTTPResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyHTTPResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+indexerProxyHTTPResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -190,14 +215,21 @@ func (r *IndexerProxyHTTPResource) Update(ctx context.Context, req resource.Upda
 
 	// Update IndexerProxyHTTP
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.UpdateIndexerProxy(r.auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyHTTPResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, indexerProxyHTTPResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+indexerProxyHTTPResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -226,7 +258,7 @@ func (r *IndexerProxyHTTPResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *IndexerProxyHTTPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, indexerProxyHTTPResourceName, path.Root("id"), req, resp, indexerProxyImportLookup(r.auth, r.client, indexerProxyHTTPImplementation))
 	tflog.Trace(ctx, "imported "+indexerProxyHTTPResourceName+": "+req.ID)
 }
 
@@ -24,6 +24,7 @@ const indexerProxyResourceName = "indexer_proxy"
 var (
 	_ resource.Resource                = &IndexerProxyResource{}
 	_ resource.ResourceWithImportState = &IndexerProxyResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxyResource{}
 )
 
 var indexerProxyFields = helpers.Fields{
@@ -37,8 +38,9 @@ func NewIndexerProxyResource() resource.Resource {
 
 // IndexerProxyResource defines the indexer proxy implementation.
 type IndexerProxyResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // IndexerProxy describes the indexer proxy data model.
@@ -55,6 +57,13 @@ type IndexerProxy struct {
 	ID             types.Int64  `tfsdk:"id"`
 }
 
+// IndexerProxyResourceData adds the resource only attributes to the indexer proxy data model,
+// which is shared with the data sources.
+type IndexerProxyResourceData struct {
+	IndexerProxy
+	TagsAll types.Set `tfsdk:"tags_all"`
+}
+
 func (i IndexerProxy) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -97,6 +106,11 @@ func (r *IndexerProxyResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer Proxy ID.",
 				Computed:            true,
@@ -130,6 +144,9 @@ func (r *IndexerProxyResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 		},
 	}
@@ -139,12 +156,17 @@ func (r *IndexerProxyResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *IndexerProxyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var proxy *IndexerProxy
+	var proxy *IndexerProxyResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &proxy)...)
 
@@ -154,27 +176,34 @@ func (r *IndexerProxyResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Create new IndexerProxy
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.CreateIndexerProxy(r.auth).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, indexerProxyResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	state := IndexerProxyResourceData{TagsAll: proxy.TagsAll}
 
-	state.writeSensitive(proxy)
+	state.writeSensitive(&proxy.IndexerProxy)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var proxy *IndexerProxy
+	var proxy *IndexerProxyResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &proxy)...)
 
@@ -183,26 +212,28 @@ func (r *IndexerProxyResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get IndexerProxy current value
-	response, _, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxyResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxyResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	state := IndexerProxyResourceData{TagsAll: proxy.TagsAll}
 
-	state.writeSensitive(proxy)
+	state.writeSensitive(&proxy.IndexerProxy)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var proxy *IndexerProxy
+	var proxy *IndexerProxyResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &proxy)...)
 
@@ -212,20 +243,27 @@ func (r *IndexerProxyResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Update IndexerProxy
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.UpdateIndexerProxy(r.auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, indexerProxyResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+indexerProxyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state IndexerProxy
+	state := IndexerProxyResourceData{TagsAll: proxy.TagsAll}
 
-	state.writeSensitive(proxy)
+	state.writeSensitive(&proxy.IndexerProxy)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -252,10 +290,28 @@ func (r *IndexerProxyResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *IndexerProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, indexerProxyResourceName, path.Root("id"), req, resp, indexerProxyImportLookup(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+indexerProxyResourceName+": "+req.ID)
 }
 
+// indexerProxyImportLookup resolves indexer proxies by `name:<name>` on import.
+// Typed resources pass their implementation, so that other implementations are rejected.
+func indexerProxyImportLookup(auth context.Context, client *prowlarr.APIClient, implementation string) helpers.ImportLookup[prowlarr.IndexerProxyResource] {
+	return helpers.ImportLookup[prowlarr.IndexerProxyResource]{
+		Keys: map[string]func(*prowlarr.IndexerProxyResource) string{
+			"name": (*prowlarr.IndexerProxyResource).GetName,
+		},
+		List: func() ([]prowlarr.IndexerProxyResource, error) {
+			response, _, err := client.IndexerProxyAPI.ListIndexerProxy(auth).Execute()
+
+			return response, err
+		},
+		ID:             (*prowlarr.IndexerProxyResource).GetId,
+		Implementation: (*prowlarr.IndexerProxyResource).GetImplementation,
+		Expected:       implementation,
+	}
+}
+
 func (i *IndexerProxy) write(ctx context.Context, indexerProxy *prowlarr.IndexerProxyResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
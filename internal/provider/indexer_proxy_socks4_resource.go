@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerProxySocks4Resource{}
 	_ resource.ResourceWithImportState = &IndexerProxySocks4Resource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerProxySocks4Resource{}
 )
 
 func NewIndexerProxySocks4Resource() resource.Resource {
@@ -35,13 +36,15 @@ func NewIndexerProxySocks4Resource() resource.Resource {
 
 // IndexerProxySocks4Resource defines the indexer proxy implementation.
 type IndexerProxySocks4Resource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // IndexerProxySocks4 describes the indexer proxy data model.
 type IndexerProxySocks4 struct {
 	Tags     types.Set    `tfsdk:"tags"`
+	TagsAll  types.Set    `tfsdk:"tags_all"`
 	Name     types.String `tfsdk:"name"`
 	Host     types.String `tfsdk:"host"`
 	Username types.String `tfsdk:"username"`
@@ -92,6 +95,11 @@ func (r *IndexerProxySocks4Resource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer Proxy ID.",
 				Computed:            true,
@@ -116,6 +124,9 @@ func (r *IndexerProxySocks4Resource) Schema(_ context.Context, _ resource.Schema
 				MarkdownDescription: "Password.",
 				Required:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 		},
 	}
@@ -125,9 +136,14 @@ func (r *IndexerProxySocks4Resource) Configure(ctx context.Context, req resource
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *IndexerProxySocks4Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *IndexerProxySocks4Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var proxy *IndexerProxySocks4
@@ -140,14 +156,21 @@ func (r *IndexerProxySocks4Resource) Create(ctx context.Context, req resource.Cr
 
 	// Create new IndexerProxySocks4
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.CreateIndexerProxy(r.auth).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerProxySocks4ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, indexerProxySocks4ResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerProxySocks4ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -165,13 +188,15 @@ func (r *IndexerProxySocks4Resource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get IndexerProxySocks4 current value
-	response, _, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerProxyAPI.GetIndexerProxyById(r.auth, int32(proxy.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerProxySocks4ResourceName, err))
+		helpers.HandleReadError(ctx, indexerProxySocks4ResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+indexerProxySocks4ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -190,14 +215,21 @@ func (r *IndexerProxySocks4Resource) Update(ctx context.Context, req resource.Up
 
 	// Update IndexerProxySocks4
 	request := proxy.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	response, _, err := r.client.IndexerProxyAPI.UpdateIndexerProxy(r.auth, strconv.Itoa(int(request.GetId()))).IndexerProxyResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerProxySocks4ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, indexerProxySocks4ResourceName, err)
 
 		return
 	}
 
+	response.Tags, proxy.TagsAll = r.defaultTags.split(ctx, response.Tags, proxy.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+indexerProxySocks4ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	proxy.write(ctx, response, &resp.Diagnostics)
@@ -226,7 +258,7 @@ func (r *IndexerProxySocks4Resource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *IndexerProxySocks4Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, indexerProxySocks4ResourceName, path.Root("id"), req, resp, indexerProxyImportLookup(r.auth, r.client, indexerProxySocks4Implementation))
 	tflog.Trace(ctx, "imported "+indexerProxySocks4ResourceName+": "+req.ID)
 }
 
@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,15 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const indexerResourceName = "indexer"
+const (
+	indexerResourceName = "indexer"
+	// numberPrecision is the precision used by terraform to parse number values.
+	numberPrecision = 512
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerResource{}
-	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.Resource                   = &IndexerResource{}
+	_ resource.ResourceWithImportState    = &IndexerResource{}
+	_ resource.ResourceWithValidateConfig = &IndexerResource{}
+	_ resource.ResourceWithModifyPlan     = &IndexerResource{}
 )
 
 func NewIndexerResource() resource.Resource {
@@ -33,8 +44,10 @@ func NewIndexerResource() resource.Resource {
 
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
+	schemaCache *SchemaCache
 }
 
 // Indexer describes the indexer data model.
@@ -54,9 +67,18 @@ type Indexer struct {
 	Enable         types.Bool   `tfsdk:"enable"`
 }
 
+// IndexerResourceData adds the resource only attributes to the indexer data model,
+// which is shared with the data sources.
+type IndexerResourceData struct {
+	Indexer
+	TagsAll   types.Set  `tfsdk:"tags_all"`
+	ForceSave types.Bool `tfsdk:"force_save"`
+}
+
 // Field is part of Indexer.
 type Field struct {
 	SetValue       types.Set    `tfsdk:"set_value"`
+	StringSetValue types.Set    `tfsdk:"string_set_value"`
 	NumberValue    types.Number `tfsdk:"number_value"`
 	Name           types.String `tfsdk:"name"`
 	TextValue      types.String `tfsdk:"text_value"`
@@ -111,6 +133,11 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"language": schema.StringAttribute{
 				MarkdownDescription: "Language.",
 				Computed:            true,
@@ -119,6 +146,10 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "Privacy.",
 				Computed:            true,
 			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the indexer without testing it first, as Prowlarr `forceSave` does. Otherwise enabled indexers are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -171,6 +202,12 @@ func (r IndexerResource) getFieldSchema() schema.Schema {
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"string_set_value": schema.SetAttribute{
+				MarkdownDescription: "String set value. Only one value must be filled out. This must be used instead of `set_value`, for fields holding a list of strings.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -179,12 +216,14 @@ func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureR
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.schemaCache = resourceSchemaCache(req)
 	}
 }
 
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var indexer *Indexer
+	var indexer *IndexerResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -194,14 +233,30 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	// Test before saving, as Prowlarr does for enabled ones
+	if !indexer.ForceSave.ValueBool() && indexerEnabled(request) {
+		if _, err := r.client.IndexerAPI.TestIndexer(r.auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, indexerResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).ForceSave(indexer.ForceSave.ValueBool()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, indexerResourceName, err)
 
 		return
 	}
 
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	indexer.write(ctx, response, &resp.Diagnostics)
@@ -210,7 +265,7 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 
 func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var indexer *Indexer
+	var indexer *IndexerResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
 
@@ -219,13 +274,15 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get Indexer current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
+		helpers.HandleReadError(ctx, indexerResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	indexer.write(ctx, response, &resp.Diagnostics)
@@ -234,7 +291,7 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var indexer *Indexer
+	var indexer *IndexerResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -244,14 +301,30 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !indexer.ForceSave.ValueBool() && indexerEnabled(request) {
+		if _, err := r.client.IndexerAPI.TestIndexer(r.auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, indexerResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(indexer.ForceSave.ValueBool()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, indexerResourceName, err)
 
 		return
 	}
 
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	indexer.write(ctx, response, &resp.Diagnostics)
@@ -280,10 +353,154 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, indexerResourceName, path.Root("id"), req, resp, indexerImportLookup(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
+// indexerImportLookup resolves indexers by `name:<name>` or by `definition:<implementation>/<definition>` (e.g. `definition:Cardigann/1337x`) on import.
+// Typed resources pass their implementation, so that other implementations are rejected.
+func indexerImportLookup(auth context.Context, client *prowlarr.APIClient, implementation string) helpers.ImportLookup[prowlarr.IndexerResource] {
+	return helpers.ImportLookup[prowlarr.IndexerResource]{
+		Keys: map[string]func(*prowlarr.IndexerResource) string{
+			"name": (*prowlarr.IndexerResource).GetName,
+			"definition": func(i *prowlarr.IndexerResource) string {
+				return i.GetImplementation() + "/" + i.GetDefinitionName()
+			},
+		},
+		List: func() ([]prowlarr.IndexerResource, error) {
+			response, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+
+			return response, err
+		},
+		ID:             (*prowlarr.IndexerResource).GetId,
+		Implementation: (*prowlarr.IndexerResource).GetImplementation,
+		Expected:       implementation,
+	}
+}
+
+func (r *IndexerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var fields types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("fields"), &fields)...)
+
+	if resp.Diagnostics.HasError() || fields.IsNull() || fields.IsUnknown() {
+		return
+	}
+
+	names := make(map[string]bool, len(fields.Elements()))
+
+	for _, element := range fields.Elements() {
+		elementPath := path.Root("fields").AtSetValue(element)
+
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		var field Field
+
+		resp.Diagnostics.Append(object.As(ctx, &field, basetypes.ObjectAsOptions{})...)
+
+		if values := field.countValues(); values != 1 {
+			resp.Diagnostics.AddAttributeError(elementPath, "Invalid Indexer Field",
+				fmt.Sprintf("Field '%s' must have exactly one value among text_value, sensitive_value, number_value, bool_value, set_value and string_set_value, got %d.", field.Name.ValueString(), values))
+		}
+
+		if field.Name.IsUnknown() {
+			continue
+		}
+
+		if names[field.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(elementPath, "Duplicate Indexer Field",
+				fmt.Sprintf("Field '%s' is defined more than once.", field.Name.ValueString()))
+		}
+
+		names[field.Name.ValueString()] = true
+	}
+}
+
+func (r *IndexerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.defaultTags.modifyPlan(ctx, req, resp)
+	warnMaskedFields(ctx, req, resp)
+
+	// Only validate against the live schema when something it covers changed.
+	if r.client == nil || resp.Diagnostics.HasError() || indexerFieldsUnchanged(ctx, req, &resp.Diagnostics) {
+		return
+	}
+
+	var config *IndexerResourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Fields cannot be validated until all of them are known.
+	if resp.Diagnostics.HasError() || config.Implementation.IsUnknown() || config.ConfigContract.IsUnknown() || hasUnknownFields(config.Fields) {
+		return
+	}
+
+	// Cardigann indexers are identified by their definition file, the others by their implementation.
+	definition := config.Implementation.ValueString()
+	if field, ok := config.getFieldMap(ctx, &resp.Diagnostics)["definitionFile"]; ok {
+		if field.TextValue.IsUnknown() {
+			return
+		}
+
+		definition = field.TextValue.ValueString()
+	}
+
+	schemas, err := r.schemaCache.list(ctx, r.auth, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
+
+		return
+	}
+
+	indexerSchema := findIndexerImplementation(config.Implementation.ValueString(), config.ConfigContract.ValueString(), definition, schemas)
+	if indexerSchema == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("implementation"), "Invalid Indexer Implementation",
+			fmt.Sprintf("No indexer schema found for implementation '%s', config contract '%s' and definition '%s'.", config.Implementation.ValueString(), config.ConfigContract.ValueString(), definition))
+
+		return
+	}
+
+	schemaFields := make(map[string]prowlarr.Field, len(indexerSchema.GetFields()))
+	for _, f := range indexerSchema.GetFields() {
+		schemaFields[f.GetName()] = f
+	}
+
+	for _, element := range config.Fields.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		var field Field
+
+		resp.Diagnostics.Append(object.As(ctx, &field, basetypes.ObjectAsOptions{})...)
+
+		if field.Name.IsUnknown() || field.countValues() != 1 {
+			continue
+		}
+
+		schemaField, ok := schemaFields[field.Name.ValueString()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("fields").AtSetValue(element), "Invalid Indexer Field",
+				fmt.Sprintf("Field '%s' is not defined for indexer '%s'.", field.Name.ValueString(), indexerSchema.GetDefinitionName()))
+
+			continue
+		}
+
+		if message := field.validate(ctx, &schemaField); message != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("fields").AtSetValue(element), "Invalid Indexer Field",
+				fmt.Sprintf("Field '%s' %s.", field.Name.ValueString(), message))
+		}
+	}
+}
+
 func (i *Indexer) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -330,13 +547,14 @@ func (f *Field) write(ctx context.Context, field *prowlarr.Field, indexer *Index
 	f.SensitiveValue = types.StringNull()
 	f.TextValue = types.StringNull()
 	f.SetValue = types.SetNull(types.Int64Type)
+	f.StringSetValue = types.SetNull(types.StringType)
 
 	if _, ok := field.GetValueOk(); ok {
 		switch v := field.GetValue().(type) {
 		case bool:
 			f.BoolValue = types.BoolValue(v)
 		case float64:
-			f.NumberValue = types.NumberValue(big.NewFloat(v))
+			f.NumberValue = numberValue(v)
 		case string:
 			if v == helpers.SensitiveValue {
 				f.SensitiveValue = indexer.findSensitive(ctx, field.GetName(), diags)
@@ -344,6 +562,13 @@ func (f *Field) write(ctx context.Context, field *prowlarr.Field, indexer *Index
 				f.TextValue = types.StringValue(v)
 			}
 		case []interface{}:
+			if isStringSlice(v) || (len(v) == 0 && indexer.isStringSetField(ctx, field.GetName(), diags)) {
+				f.StringSetValue, tempDiag = types.SetValueFrom(ctx, types.StringType, v)
+				diags.Append(tempDiag...)
+
+				return
+			}
+
 			setValue := make([]*int64, len(v))
 
 			for i, value := range v {
@@ -359,6 +584,117 @@ func (f *Field) write(ctx context.Context, field *prowlarr.Field, indexer *Index
 	}
 }
 
+// numberValue converts a float to number with the same precision terraform uses for configuration values.
+// This avoids drift when decimal values are not exactly representable as float.
+func numberValue(value float64) types.Number {
+	number, _, err := big.ParseFloat(strconv.FormatFloat(value, 'f', -1, 64), 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		return types.NumberValue(big.NewFloat(value))
+	}
+
+	return types.NumberValue(number)
+}
+
+// isStringSlice checks if the slice is not empty and contains only strings.
+func isStringSlice(values []interface{}) bool {
+	for _, v := range values {
+		if _, ok := v.(string); !ok {
+			return false
+		}
+	}
+
+	return len(values) > 0
+}
+
+// isStringSetField checks if the field was previously managed as a string set.
+func (i *Indexer) isStringSetField(ctx context.Context, name string, diags *diag.Diagnostics) bool {
+	if i == nil || i.Fields.IsNull() || i.Fields.IsUnknown() {
+		return false
+	}
+
+	field, ok := i.getFieldMap(ctx, diags)[name]
+
+	return ok && !field.StringSetValue.IsNull()
+}
+
+// hasUnknownFields checks if the field set, or any of its fields, is unknown.
+func hasUnknownFields(fields types.Set) bool {
+	if fields.IsUnknown() {
+		return true
+	}
+
+	for _, element := range fields.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			return true
+		}
+
+		for _, value := range object.Attributes() {
+			if value.IsUnknown() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// indexerFieldsUnchanged reports whether the plan keeps the implementation, config contract and fields of the prior state.
+func indexerFieldsUnchanged(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) bool {
+	if req.State.Raw.IsNull() {
+		return false
+	}
+
+	var state, plan *IndexerResourceData
+
+	diags.Append(req.State.Get(ctx, &state)...)
+	diags.Append(req.Plan.Get(ctx, &plan)...)
+
+	if diags.HasError() {
+		return false
+	}
+
+	return plan.Implementation.Equal(state.Implementation) && plan.ConfigContract.Equal(state.ConfigContract) && plan.Fields.Equal(state.Fields)
+}
+
+// warnMaskedFields warns about the sensitive fields that are masked in state,
+// since their configured value is sent once in place of the masked one.
+func warnMaskedFields(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *IndexerResourceData
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.Fields.IsNull() || plan.Fields.IsUnknown() {
+		return
+	}
+
+	masked := make(map[string]bool)
+
+	for name, f := range state.getFieldMap(ctx, &resp.Diagnostics) {
+		masked[name] = helpers.IsMasked(f.SensitiveValue)
+	}
+
+	for _, element := range plan.Fields.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		var field Field
+
+		resp.Diagnostics.Append(object.As(ctx, &field, basetypes.ObjectAsOptions{})...)
+
+		if masked[field.Name.ValueString()] && !field.SensitiveValue.IsNull() && !field.SensitiveValue.IsUnknown() && !helpers.IsMasked(field.SensitiveValue) {
+			helpers.AddMaskedWarning(&resp.Diagnostics, path.Root("fields").AtSetValue(element).AtName("sensitive_value"))
+		}
+	}
+}
+
 func (i *Indexer) findSensitive(ctx context.Context, name string, diags *diag.Diagnostics) types.String {
 	if dim := len(i.Fields.Elements()); dim > 0 {
 		fieldList := make([]Field, dim)
@@ -371,7 +707,8 @@ func (i *Indexer) findSensitive(ctx context.Context, name string, diags *diag.Di
 		}
 	}
 
-	return types.StringValue("")
+	// The real value is unknown (e.g. after import), keep it masked.
+	return types.StringValue(helpers.SensitiveValue)
 }
 
 func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
@@ -407,7 +744,13 @@ func (f *Field) read(ctx context.Context, diags *diag.Diagnostics) prowlarr.Fiel
 	}
 
 	if !f.NumberValue.IsNull() && !f.NumberValue.IsUnknown() {
-		field.SetValue(f.NumberValue.ValueBigFloat())
+		// send numbers as JSON numbers, keeping integers as such
+		if value, accuracy := f.NumberValue.ValueBigFloat().Int64(); accuracy == big.Exact {
+			field.SetValue(value)
+		} else {
+			value, _ := f.NumberValue.ValueBigFloat().Float64()
+			field.SetValue(value)
+		}
 	}
 
 	if !f.TextValue.IsNull() && !f.TextValue.IsUnknown() {
@@ -424,5 +767,234 @@ func (f *Field) read(ctx context.Context, diags *diag.Diagnostics) prowlarr.Fiel
 		field.SetValue(set)
 	}
 
+	if !f.StringSetValue.IsNull() && !f.StringSetValue.IsUnknown() {
+		set := make([]string, len(f.StringSetValue.Elements()))
+		diags.Append(f.StringSetValue.ElementsAs(ctx, &set, true)...)
+		field.SetValue(set)
+	}
+
 	return *field
 }
+
+// getFieldMap returns the indexer fields indexed by name.
+func (i *Indexer) getFieldMap(ctx context.Context, diags *diag.Diagnostics) map[string]Field {
+	fieldList := make([]Field, len(i.Fields.Elements()))
+	diags.Append(i.Fields.ElementsAs(ctx, &fieldList, true)...)
+
+	fields := make(map[string]Field, len(fieldList))
+	for _, f := range fieldList {
+		fields[f.Name.ValueString()] = f
+	}
+
+	return fields
+}
+
+// setFields populates the indexer fields, skipping the ones without a known value.
+func (i *Indexer) setFields(ctx context.Context, fields []Field, diags *diag.Diagnostics) {
+	var (
+		tempDiag diag.Diagnostics
+		output   []Field
+	)
+
+	for _, f := range fields {
+		if !f.isEmpty() {
+			output = append(output, f)
+		}
+	}
+
+	i.Fields, tempDiag = types.SetValueFrom(ctx, IndexerResource{}.getFieldSchema().Type(), output)
+	diags.Append(tempDiag...)
+}
+
+// newField returns a field with all values set to null.
+func newField(name string) Field {
+	return Field{
+		Name:           types.StringValue(name),
+		BoolValue:      types.BoolNull(),
+		NumberValue:    types.NumberNull(),
+		SensitiveValue: types.StringNull(),
+		TextValue:      types.StringNull(),
+		SetValue:       types.SetNull(types.Int64Type),
+		StringSetValue: types.SetNull(types.StringType),
+	}
+}
+
+// newTextField returns a field with a text value.
+func newTextField(name string, value types.String) Field {
+	f := newField(name)
+	f.TextValue = value
+
+	return f
+}
+
+// newSensitiveField returns a field with a sensitive value.
+func newSensitiveField(name string, value types.String) Field {
+	f := newField(name)
+	f.SensitiveValue = value
+
+	return f
+}
+
+// newBoolField returns a field with a bool value.
+func newBoolField(name string, value types.Bool) Field {
+	f := newField(name)
+	f.BoolValue = value
+
+	return f
+}
+
+// newInt64Field returns a field with an int value.
+func newInt64Field(name string, value types.Int64) Field {
+	f := newField(name)
+	if !value.IsNull() && !value.IsUnknown() {
+		f.NumberValue = types.NumberValue(big.NewFloat(float64(value.ValueInt64())))
+	}
+
+	return f
+}
+
+// newFloat64Field returns a field with a float value.
+func newFloat64Field(name string, value types.Float64) Field {
+	f := newField(name)
+	if !value.IsNull() && !value.IsUnknown() {
+		f.NumberValue = numberValue(value.ValueFloat64())
+	}
+
+	return f
+}
+
+// valueKind returns the attribute name of the configured value.
+func (f Field) valueKind() string {
+	switch {
+	case !f.BoolValue.IsNull():
+		return "bool_value"
+	case !f.NumberValue.IsNull():
+		return "number_value"
+	case !f.SensitiveValue.IsNull():
+		return "sensitive_value"
+	case !f.TextValue.IsNull():
+		return "text_value"
+	case !f.StringSetValue.IsNull():
+		return "string_set_value"
+	default:
+		return "set_value"
+	}
+}
+
+// validate checks the field value against its schema, returning a message if it is not valid.
+func (f Field) validate(ctx context.Context, schemaField *prowlarr.Field) string {
+	var expected []string
+
+	options := schemaField.GetSelectOptions()
+
+	switch schemaField.GetType() {
+	case "info":
+		return "is informational and cannot be set"
+	case "checkbox":
+		expected = []string{"bool_value"}
+	case "number":
+		expected = []string{"number_value"}
+	case "tag", "tagSelect":
+		expected = []string{"set_value", "string_set_value"}
+	case "textbox", "textArea", "password", "url", "path", "filePath", "captcha", "cardigannCaptcha":
+		expected = []string{"text_value", "sensitive_value"}
+	case "select":
+		switch _, isList := schemaField.GetValue().([]interface{}); {
+		case isList:
+			expected = []string{"set_value", "string_set_value"}
+		case schemaField.GetSelectOptionsProviderAction() != "" || len(options) == 0:
+			expected = []string{"number_value", "text_value"}
+		default:
+			expected = []string{"number_value"}
+		}
+	default:
+		return ""
+	}
+
+	if kind := f.valueKind(); !slices.Contains(expected, kind) {
+		return fmt.Sprintf("of type '%s' expects %s, got %s", schemaField.GetType(), strings.Join(expected, " or "), kind)
+	}
+
+	if schemaField.GetType() != "select" || len(options) == 0 {
+		return ""
+	}
+
+	var values []int64
+
+	switch {
+	case !f.NumberValue.IsNull() && !f.NumberValue.IsUnknown():
+		value, _ := f.NumberValue.ValueBigFloat().Int64()
+		values = append(values, value)
+	case !f.SetValue.IsNull() && !f.SetValue.IsUnknown():
+		values = make([]int64, len(f.SetValue.Elements()))
+		f.SetValue.ElementsAs(ctx, &values, true)
+	}
+
+	for _, v := range values {
+		if !isSelectOption(options, int32(v)) {
+			return fmt.Sprintf("value %d is not valid, valid values are: %s", v, formatSelectOptions(options))
+		}
+	}
+
+	return ""
+}
+
+// countValues returns the number of configured values.
+func (f Field) countValues() int {
+	count := 0
+
+	for _, v := range []attr.Value{f.BoolValue, f.NumberValue, f.SensitiveValue, f.TextValue, f.SetValue, f.StringSetValue} {
+		if !v.IsNull() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// isEmpty checks if the field has no known value.
+func (f Field) isEmpty() bool {
+	for _, v := range []attr.Value{f.BoolValue, f.NumberValue, f.SensitiveValue, f.TextValue, f.SetValue, f.StringSetValue} {
+		if !v.IsNull() && !v.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// stringValue returns the text value or the sensitive one.
+func (f Field) stringValue() types.String {
+	if !f.TextValue.IsNull() {
+		return f.TextValue
+	}
+
+	return f.SensitiveValue
+}
+
+// int64Value returns the number value as int.
+func (f Field) int64Value() types.Int64 {
+	if f.NumberValue.IsNull() || f.NumberValue.IsUnknown() {
+		return types.Int64Null()
+	}
+
+	value, _ := f.NumberValue.ValueBigFloat().Int64()
+
+	return types.Int64Value(value)
+}
+
+// float64Value returns the number value as float.
+func (f Field) float64Value() types.Float64 {
+	if f.NumberValue.IsNull() || f.NumberValue.IsUnknown() {
+		return types.Float64Null()
+	}
+
+	value, _ := f.NumberValue.ValueBigFloat().Float64()
+
+	return types.Float64Value(value)
+}
+
+// indexerEnabled mirrors Prowlarr, which tests only enabled indexers on save.
+func indexerEnabled(indexer *prowlarr.IndexerResource) bool {
+	return indexer.GetEnable()
+}
@@ -1,11 +1,29 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"regexp"
+	"slices"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIndexerResource(t *testing.T) {
@@ -47,11 +65,92 @@ func TestAccIndexerResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "prowlarr_indexer.test",
+				ImportState:       true,
+				ImportStateId:     "name:resourceTest",
+				ImportStateVerify: true,
+			},
+			// ImportState by missing name testing
+			{
+				ResourceName:  "prowlarr_indexer.test",
+				ImportState:   true,
+				ImportStateId: "name:missingIndexer",
+				ExpectError:   regexp.MustCompile("No indexer found"),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func TestAccIndexerResourceValidation(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Multiple values
+			{
+				Config:      testAccIndexerResourceValidationConfig(`{ name = "baseUrl", text_value = "https://0magnet.co/", number_value = 1 }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Indexer Field"),
+			},
+			// No value
+			{
+				Config:      testAccIndexerResourceValidationConfig(`{ name = "baseUrl" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Indexer Field"),
+			},
+			// Duplicate name
+			{
+				Config:      testAccIndexerResourceValidationConfig(`{ name = "definitionFile", text_value = "1337x" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate Indexer Field"),
+			},
+			// Unknown field
+			{
+				Config:      testAccIndexerResourceValidationConfig(`{ name = "baseSettings.limitsUnitt", number_value = 0 }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not defined for indexer"),
+			},
+			// Wrong value kind
+			{
+				Config:      testAccIndexerResourceValidationConfig(`{ name = "baseSettings.queryLimit", text_value = "10" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expects number_value"),
+			},
+			// Invalid select option
+			{
+				Config:      testAccIndexerResourceValidationConfig(`{ name = "baseSettings.limitsUnit", number_value = 5 }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("valid values are"),
+			},
+		},
+	})
+}
+
+func testAccIndexerResourceValidationConfig(field string) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer" "test" {
+		enable = false
+		name = "validationTest"
+		implementation = "Cardigann"
+		config_contract = "CardigannSettings"
+		protocol = "torrent"
+		app_profile_id = 1
+
+		fields = [
+			{
+				name = "definitionFile"
+				text_value = "0magnet"
+			},
+			%s
+		]
+	}`, field)
+}
+
 func testAccIndexerResourceConfig(name, url string) string {
 	return fmt.Sprintf(`
 	resource "prowlarr_indexer" "test" {
@@ -153,3 +252,333 @@ func testAccIndexerResourceConfig(name, url string) string {
 	}
 	`, name, url, name)
 }
+
+// configNumber returns the number as parsed by terraform from configuration.
+func configNumber(t *testing.T, value string) types.Number {
+	t.Helper()
+
+	number, _, err := big.ParseFloat(value, 10, numberPrecision, big.ToNearestEven)
+	assert.NoError(t, err)
+
+	return types.NumberValue(number)
+}
+
+func newNumberField(t *testing.T, name, value string) Field {
+	t.Helper()
+
+	f := newField(name)
+	f.NumberValue = configNumber(t, value)
+
+	return f
+}
+
+func newStringSetField(name string, values ...string) Field {
+	f := newField(name)
+	f.StringSetValue, _ = types.SetValueFrom(context.Background(), types.StringType, values)
+
+	return f
+}
+
+func newInt64SetField(name string, values ...int64) Field {
+	f := newField(name)
+	f.SetValue, _ = types.SetValueFrom(context.Background(), types.Int64Type, values)
+
+	return f
+}
+
+func newIndexerWithFields(t *testing.T, fields ...Field) *Indexer {
+	t.Helper()
+
+	set, diags := types.SetValueFrom(context.Background(), IndexerResource{}.getFieldSchema().Type(), fields)
+	assert.False(t, diags.HasError())
+
+	return &Indexer{Fields: set}
+}
+
+func TestFieldRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field  Field
+		state  []Field
+		masked bool
+	}{
+		"bool": {
+			field: newBoolField("enabled", types.BoolValue(true)),
+		},
+		"text": {
+			field: newTextField("baseUrl", types.StringValue("https://example.com")),
+		},
+		"sensitive": {
+			field:  newSensitiveField("apiKey", types.StringValue("secret")),
+			state:  []Field{newSensitiveField("apiKey", types.StringValue("secret"))},
+			masked: true,
+		},
+		"integer": {
+			field: newInt64Field("minimumSeeders", types.Int64Value(3)),
+		},
+		"negative integer": {
+			field: newNumberField(t, "offset", "-3"),
+		},
+		"large integer": {
+			field: newNumberField(t, "limit", "9007199254740992"),
+		},
+		"half": {
+			field: newNumberField(t, "seedRatio", "0.5"),
+		},
+		"decimal": {
+			field: newNumberField(t, "seedRatio", "0.1"),
+		},
+		"int set": {
+			field: newInt64SetField("categories", 2000, 5000, 1),
+		},
+		"string set": {
+			field: newStringSetField("sort", "name", "size", "added"),
+		},
+		"empty string set": {
+			field: newStringSetField("sort"),
+			state: []Field{newStringSetField("sort", "name")},
+		},
+		"empty int set": {
+			field: newInt64SetField("categories"),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			ctx := context.Background()
+			sent := test.field.read(ctx, &diags)
+
+			// Send the field through JSON, as the API does
+			body, err := json.Marshal(sent)
+			assert.NoError(t, err)
+
+			var received prowlarr.Field
+
+			assert.NoError(t, json.Unmarshal(body, &received))
+
+			// Prowlarr masks sensitive values
+			if test.masked {
+				received.SetValue(helpers.SensitiveValue)
+			}
+
+			// Prowlarr does not preserve the order of the values
+			if values, ok := received.GetValue().([]interface{}); ok {
+				slices.Reverse(values)
+				received.SetValue(values)
+			}
+
+			var state *Indexer
+			if test.state != nil {
+				state = newIndexerWithFields(t, test.state...)
+			}
+
+			var written Field
+
+			written.write(ctx, &received, state, &diags)
+
+			assert.False(t, diags.HasError())
+
+			expected := []attr.Value{test.field.Name, test.field.BoolValue, test.field.NumberValue, test.field.TextValue, test.field.SensitiveValue, test.field.SetValue, test.field.StringSetValue}
+			actual := []attr.Value{written.Name, written.BoolValue, written.NumberValue, written.TextValue, written.SensitiveValue, written.SetValue, written.StringSetValue}
+
+			for n := range expected {
+				assert.True(t, expected[n].Equal(actual[n]), "expected %s, got %s", expected[n], actual[n])
+			}
+		})
+	}
+}
+
+func TestNumberValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected string
+		value    float64
+	}{
+		"zero":          {value: 0, expected: "0"},
+		"half":          {value: 0.5, expected: "0.5"},
+		"decimal":       {value: 0.1, expected: "0.1"},
+		"ratio":         {value: 1.25, expected: "1.25"},
+		"negative":      {value: -2.3, expected: "-2.3"},
+		"integer":       {value: 4294967296, expected: "4294967296"},
+		"large integer": {value: 9007199254740992, expected: "9007199254740992"},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			number := numberValue(test.value)
+			assert.True(t, configNumber(t, test.expected).Equal(number), "expected %s, got %s", test.expected, number)
+
+			value, _ := number.ValueBigFloat().Float64()
+			assert.Equal(t, test.value, value)
+		})
+	}
+}
+
+func TestIsStringSetField(t *testing.T) {
+	t.Parallel()
+
+	indexer := newIndexerWithFields(t,
+		newStringSetField("sort", "name"),
+		newInt64SetField("categories", 2000),
+		newTextField("baseUrl", types.StringValue("https://example.com")),
+	)
+
+	tests := map[string]struct {
+		indexer  *Indexer
+		name     string
+		expected bool
+	}{
+		"string set": {
+			indexer:  indexer,
+			name:     "sort",
+			expected: true,
+		},
+		"int set": {
+			indexer: indexer,
+			name:    "categories",
+		},
+		"text": {
+			indexer: indexer,
+			name:    "baseUrl",
+		},
+		"missing": {
+			indexer: indexer,
+			name:    "other",
+		},
+		"nil indexer": {
+			name: "sort",
+		},
+		"null fields": {
+			indexer: &Indexer{Fields: types.SetNull(IndexerResource{}.getFieldSchema().Type())},
+			name:    "sort",
+		},
+		"unknown fields": {
+			indexer: &Indexer{Fields: types.SetUnknown(IndexerResource{}.getFieldSchema().Type())},
+			name:    "sort",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			assert.Equal(t, test.expected, test.indexer.isStringSetField(context.Background(), test.name, &diags))
+			assert.False(t, diags.HasError())
+		})
+	}
+}
+
+func TestIndexerModifyPlanSchemaCalls(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var schemaResponse fwresource.SchemaResponse
+
+	(&IndexerResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+
+	indexer := func(url string) tfsdk.State {
+		var diags diag.Diagnostics
+
+		data := IndexerResourceData{
+			Indexer: Indexer{
+				Tags:           types.SetNull(types.Int64Type),
+				Implementation: types.StringValue("Torznab"),
+				ConfigContract: types.StringValue("TorznabSettings"),
+				Name:           types.StringValue("test"),
+				Protocol:       types.StringValue("torrent"),
+				Language:       types.StringValue("en-US"),
+				Privacy:        types.StringValue("public"),
+				AppProfileID:   types.Int64Value(1),
+				Priority:       types.Int64Value(25),
+				ID:             types.Int64Value(1),
+				Enable:         types.BoolValue(false),
+			},
+			TagsAll:   types.SetNull(types.Int64Type),
+			ForceSave: types.BoolValue(false),
+		}
+		data.setFields(ctx, []Field{newTextField("baseUrl", types.StringValue(url))}, &diags)
+		assert.False(t, diags.HasError())
+
+		state := tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil)}
+		assert.False(t, state.Set(ctx, &data).HasError())
+
+		return state
+	}
+
+	tests := map[string]struct {
+		url   string
+		calls int32
+	}{
+		"unchanged": {
+			url:   "https://torznab.example.com",
+			calls: 0,
+		},
+		"changed fields": {
+			url:   "https://other.example.com",
+			calls: 1,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.URL.Path != "/api/v1/indexer/schema" {
+					w.WriteHeader(http.StatusNotFound)
+
+					return
+				}
+
+				calls.Add(1)
+				_, _ = w.Write([]byte(`[{"name":"Torznab","implementation":"Torznab","configContract":"TorznabSettings","definitionName":"Torznab","fields":[{"name":"baseUrl","value":""}]}]`))
+			}))
+			t.Cleanup(server.Close)
+
+			apiURL, err := url.Parse(server.URL)
+			assert.NoError(t, err)
+
+			r := &IndexerResource{
+				client:      prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+				auth:        newAuthContext(apiURL, "key"),
+				schemaCache: NewSchemaCache("", apiURL.Host, time.Hour),
+			}
+
+			state := indexer("https://torznab.example.com")
+			plan := indexer(test.url)
+			req := fwresource.ModifyPlanRequest{
+				State:  state,
+				Plan:   tfsdk.Plan(plan),
+				Config: tfsdk.Config(plan),
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+
+			r.ModifyPlan(ctx, req, resp)
+			assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, test.calls, calls.Load())
+		})
+	}
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
@@ -23,8 +25,9 @@ func NewIndexerSchemaDataSource() datasource.DataSource {
 
 // IndexerSchemaDataSource defines the indexer schema implementation.
 type IndexerSchemaDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	schemaCache *SchemaCache
 }
 
 // IndexerSchema describes the indexer data model.
@@ -139,6 +142,7 @@ func (d *IndexerSchemaDataSource) Configure(ctx context.Context, req datasource.
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.schemaCache = dataSourceSchemaCache(req)
 	}
 }
 
@@ -152,7 +156,7 @@ func (d *IndexerSchemaDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexerSchema(d.auth).Execute()
+	response, err := d.schemaCache.list(ctx, d.auth, d.client)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
 
@@ -178,6 +182,55 @@ func (i *IndexerSchema) find(ctx context.Context, name string, schemas []prowlar
 	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(indexerSchemaDataSourceName, "name", name))
 }
 
+// findIndexerDefinition returns the schema matching the given definition name.
+func findIndexerDefinition(name string, schemas []prowlarr.IndexerResource) *prowlarr.IndexerResource {
+	for _, indexer := range schemas {
+		if indexer.GetDefinitionName() == name {
+			return &indexer
+		}
+	}
+
+	return nil
+}
+
+// findIndexerImplementation returns the schema matching the given implementation and definition name.
+// Implementations with many presets (e.g. Newznab, Torznab) are matched on their generic definition,
+// named after the implementation, if no definition is given.
+func findIndexerImplementation(implementation, configContract, definition string, schemas []prowlarr.IndexerResource) *prowlarr.IndexerResource {
+	if definition == "" {
+		definition = implementation
+	}
+
+	for _, indexer := range schemas {
+		if indexer.GetImplementation() == implementation && indexer.GetConfigContract() == configContract && indexer.GetDefinitionName() == definition {
+			return &indexer
+		}
+	}
+
+	return nil
+}
+
+// isSelectOption checks if the value is among the select options.
+func isSelectOption(options []prowlarr.SelectOption, value int32) bool {
+	for _, o := range options {
+		if o.GetValue() == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// formatSelectOptions returns a human readable list of the select options.
+func formatSelectOptions(options []prowlarr.SelectOption) string {
+	valid := make([]string, len(options))
+	for n, o := range options {
+		valid[n] = fmt.Sprintf("%d (%s)", o.GetValue(), o.GetName())
+	}
+
+	return strings.Join(valid, ", ")
+}
+
 func (i *IndexerSchema) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -5,7 +5,9 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIndexerSchemaDataSource(t *testing.T) {
@@ -44,3 +46,65 @@ func testAccIndexerSchemaDataSourceConfig(label string) string {
 	}
 	`, label)
 }
+
+func TestFindIndexerImplementation(t *testing.T) {
+	t.Parallel()
+
+	schema := func(name, implementation, definition string) prowlarr.IndexerResource {
+		indexer := prowlarr.NewIndexerResource()
+		indexer.SetName(name)
+		indexer.SetImplementation(implementation)
+		indexer.SetConfigContract(implementation + "Settings")
+		indexer.SetDefinitionName(definition)
+
+		return *indexer
+	}
+
+	schemas := []prowlarr.IndexerResource{
+		schema("NZBgeek", "Newznab", "nzbgeek"),
+		schema("Generic Newznab", "Newznab", "Newznab"),
+		schema("1337x", "Cardigann", "1337x"),
+	}
+
+	tests := map[string]struct {
+		implementation string
+		definition     string
+		expected       string
+	}{
+		"generic preset": {
+			implementation: "Newznab",
+			expected:       "Generic Newznab",
+		},
+		"explicit preset": {
+			implementation: "Newznab",
+			definition:     "nzbgeek",
+			expected:       "NZBgeek",
+		},
+		"cardigann definition": {
+			implementation: "Cardigann",
+			definition:     "1337x",
+			expected:       "1337x",
+		},
+		"missing definition": {
+			implementation: "Cardigann",
+			definition:     "missing",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found := findIndexerImplementation(test.implementation, test.implementation+"Settings", test.definition, schemas)
+			if test.expected == "" {
+				assert.Nil(t, found)
+
+				return
+			}
+
+			assert.Equal(t, test.expected, found.GetName())
+		})
+	}
+}
@@ -23,8 +23,9 @@ func NewIndexerSchemasDataSource() datasource.DataSource {
 
 // IndexerSchemasDataSource defines the indexers implementation.
 type IndexerSchemasDataSource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	schemaCache *SchemaCache
 }
 
 // IndexerSchemas describes the indexers data model.
@@ -59,12 +60,13 @@ func (d *IndexerSchemasDataSource) Configure(ctx context.Context, req datasource
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.schemaCache = dataSourceSchemaCache(req)
 	}
 }
 
 func (d *IndexerSchemasDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexerSchema(d.auth).Execute()
+	response, err := d.schemaCache.list(ctx, d.auth, d.client)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemasDataSourceName, err))
 
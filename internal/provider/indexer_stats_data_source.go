@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerStatsDataSourceName = "indexer_stats"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerStatsDataSource{}
+
+func NewIndexerStatsDataSource() datasource.DataSource {
+	return &IndexerStatsDataSource{}
+}
+
+// IndexerStatsDataSource defines the indexer stats implementation.
+type IndexerStatsDataSource struct {
+	client *prowlarr.APIClient
+	auth   context.Context
+}
+
+// IndexerStats describes the indexer stats data model.
+type IndexerStats struct {
+	IndexerIDs types.Set    `tfsdk:"indexer_ids"`
+	Tags       types.Set    `tfsdk:"tags"`
+	Indexers   types.List   `tfsdk:"indexers"`
+	UserAgents types.List   `tfsdk:"user_agents"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	StartDate  types.String `tfsdk:"start_date"`
+	EndDate    types.String `tfsdk:"end_date"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// IndexerStatistics describes the single indexer stats data model.
+type IndexerStatistics struct {
+	IndexerName               types.String `tfsdk:"indexer_name"`
+	IndexerID                 types.Int64  `tfsdk:"indexer_id"`
+	AverageResponseTime       types.Int64  `tfsdk:"average_response_time"`
+	AverageGrabResponseTime   types.Int64  `tfsdk:"average_grab_response_time"`
+	NumberOfQueries           types.Int64  `tfsdk:"number_of_queries"`
+	NumberOfGrabs             types.Int64  `tfsdk:"number_of_grabs"`
+	NumberOfRssQueries        types.Int64  `tfsdk:"number_of_rss_queries"`
+	NumberOfAuthQueries       types.Int64  `tfsdk:"number_of_auth_queries"`
+	NumberOfFailedQueries     types.Int64  `tfsdk:"number_of_failed_queries"`
+	NumberOfFailedGrabs       types.Int64  `tfsdk:"number_of_failed_grabs"`
+	NumberOfFailedRssQueries  types.Int64  `tfsdk:"number_of_failed_rss_queries"`
+	NumberOfFailedAuthQueries types.Int64  `tfsdk:"number_of_failed_auth_queries"`
+}
+
+// UserAgentStatistics describes the user agent stats data model.
+type UserAgentStatistics struct {
+	UserAgent       types.String `tfsdk:"user_agent"`
+	NumberOfQueries types.Int64  `tfsdk:"number_of_queries"`
+	NumberOfGrabs   types.Int64  `tfsdk:"number_of_grabs"`
+}
+
+// HostStatistics describes the host stats data model.
+type HostStatistics struct {
+	Host            types.String `tfsdk:"host"`
+	NumberOfQueries types.Int64  `tfsdk:"number_of_queries"`
+	NumberOfGrabs   types.Int64  `tfsdk:"number_of_grabs"`
+}
+
+func (i IndexerStatistics) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"indexer_name":                  types.StringType,
+			"indexer_id":                    types.Int64Type,
+			"average_response_time":         types.Int64Type,
+			"average_grab_response_time":    types.Int64Type,
+			"number_of_queries":             types.Int64Type,
+			"number_of_grabs":               types.Int64Type,
+			"number_of_rss_queries":         types.Int64Type,
+			"number_of_auth_queries":        types.Int64Type,
+			"number_of_failed_queries":      types.Int64Type,
+			"number_of_failed_grabs":        types.Int64Type,
+			"number_of_failed_rss_queries":  types.Int64Type,
+			"number_of_failed_auth_queries": types.Int64Type,
+		})
+}
+
+func (u UserAgentStatistics) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"user_agent":        types.StringType,
+			"number_of_queries": types.Int64Type,
+			"number_of_grabs":   types.Int64Type,
+		})
+}
+
+func (h HostStatistics) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"host":              types.StringType,
+			"number_of_queries": types.Int64Type,
+			"number_of_grabs":   types.Int64Type,
+		})
+}
+
+func (d *IndexerStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerStatsDataSourceName
+}
+
+func (d *IndexerStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->\n[Indexer](../resources/indexer) statistics, optionally filtered by date range, indexers and tags.\nFor more information refer to [Stats](https://wiki.servarr.com/prowlarr/system#stats) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Start date in RFC3339 format (e.g. `2024-01-01T00:00:00Z`).",
+				Optional:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "End date in RFC3339 format (e.g. `2024-01-31T23:59:59Z`).",
+				Optional:            true,
+			},
+			"indexer_ids": schema.SetAttribute{
+				MarkdownDescription: "Indexer IDs to filter by.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tag IDs to filter by.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"indexers": schema.ListNestedAttribute{
+				MarkdownDescription: "Per indexer statistics.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"indexer_id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer ID.",
+							Computed:            true,
+						},
+						"indexer_name": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"average_response_time": schema.Int64Attribute{
+							MarkdownDescription: "Average query response time in milliseconds.",
+							Computed:            true,
+						},
+						"average_grab_response_time": schema.Int64Attribute{
+							MarkdownDescription: "Average grab response time in milliseconds.",
+							Computed:            true,
+						},
+						"number_of_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of queries.",
+							Computed:            true,
+						},
+						"number_of_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of grabs.",
+							Computed:            true,
+						},
+						"number_of_rss_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of RSS queries.",
+							Computed:            true,
+						},
+						"number_of_auth_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of authentication queries.",
+							Computed:            true,
+						},
+						"number_of_failed_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed queries.",
+							Computed:            true,
+						},
+						"number_of_failed_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed grabs.",
+							Computed:            true,
+						},
+						"number_of_failed_rss_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed RSS queries.",
+							Computed:            true,
+						},
+						"number_of_failed_auth_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed authentication queries.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"user_agents": schema.ListNestedAttribute{
+				MarkdownDescription: "Per user agent statistics.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_agent": schema.StringAttribute{
+							MarkdownDescription: "User agent.",
+							Computed:            true,
+						},
+						"number_of_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of queries.",
+							Computed:            true,
+						},
+						"number_of_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of grabs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"hosts": schema.ListNestedAttribute{
+				MarkdownDescription: "Per host statistics.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Host.",
+							Computed:            true,
+						},
+						"number_of_queries": schema.Int64Attribute{
+							MarkdownDescription: "Number of queries.",
+							Computed:            true,
+						},
+						"number_of_grabs": schema.Int64Attribute{
+							MarkdownDescription: "Number of grabs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IndexerStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *IndexerStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *IndexerStats
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := data.read(ctx, d.client.IndexerStatsAPI.GetIndexerStats(d.auth), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get indexer stats current value
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerStatsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerStatsDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (i *IndexerStats) write(ctx context.Context, stats *prowlarr.IndexerStatsResource, diags *diag.Diagnostics) {
+	indexers := make([]IndexerStatistics, len(stats.GetIndexers()))
+	for n, s := range stats.GetIndexers() {
+		indexers[n].write(&s)
+	}
+
+	userAgents := make([]UserAgentStatistics, len(stats.GetUserAgents()))
+	for n, s := range stats.GetUserAgents() {
+		userAgents[n].write(&s)
+	}
+
+	hosts := make([]HostStatistics, len(stats.GetHosts()))
+	for n, s := range stats.GetHosts() {
+		hosts[n].write(&s)
+	}
+
+	var localDiag diag.Diagnostics
+
+	i.Indexers, localDiag = types.ListValueFrom(ctx, IndexerStatistics{}.getType(), indexers)
+	diags.Append(localDiag...)
+	i.UserAgents, localDiag = types.ListValueFrom(ctx, UserAgentStatistics{}.getType(), userAgents)
+	diags.Append(localDiag...)
+	i.Hosts, localDiag = types.ListValueFrom(ctx, HostStatistics{}.getType(), hosts)
+	diags.Append(localDiag...)
+	i.ID = types.StringValue(strconv.Itoa(len(indexers)))
+}
+
+func (i *IndexerStats) read(ctx context.Context, request prowlarr.ApiGetIndexerStatsRequest, diags *diag.Diagnostics) prowlarr.ApiGetIndexerStatsRequest {
+	if date, ok := parseStatsDate(i.StartDate, path.Root("start_date"), diags); ok {
+		request = request.StartDate(date)
+	}
+
+	if date, ok := parseStatsDate(i.EndDate, path.Root("end_date"), diags); ok {
+		request = request.EndDate(date)
+	}
+
+	if ids := joinInt64Set(ctx, i.IndexerIDs, diags); ids != "" {
+		request = request.Indexers(ids)
+	}
+
+	if tags := joinInt64Set(ctx, i.Tags, diags); tags != "" {
+		request = request.Tags(tags)
+	}
+
+	return request
+}
+
+func (i *IndexerStatistics) write(stats *prowlarr.IndexerStatistics) {
+	i.IndexerID = types.Int64Value(int64(stats.GetIndexerId()))
+	i.IndexerName = types.StringValue(stats.GetIndexerName())
+	i.AverageResponseTime = types.Int64Value(int64(stats.GetAverageResponseTime()))
+	i.AverageGrabResponseTime = types.Int64Value(int64(stats.GetAverageGrabResponseTime()))
+	i.NumberOfQueries = types.Int64Value(int64(stats.GetNumberOfQueries()))
+	i.NumberOfGrabs = types.Int64Value(int64(stats.GetNumberOfGrabs()))
+	i.NumberOfRssQueries = types.Int64Value(int64(stats.GetNumberOfRssQueries()))
+	i.NumberOfAuthQueries = types.Int64Value(int64(stats.GetNumberOfAuthQueries()))
+	i.NumberOfFailedQueries = types.Int64Value(int64(stats.GetNumberOfFailedQueries()))
+	i.NumberOfFailedGrabs = types.Int64Value(int64(stats.GetNumberOfFailedGrabs()))
+	i.NumberOfFailedRssQueries = types.Int64Value(int64(stats.GetNumberOfFailedRssQueries()))
+	i.NumberOfFailedAuthQueries = types.Int64Value(int64(stats.GetNumberOfFailedAuthQueries()))
+}
+
+func (u *UserAgentStatistics) write(stats *prowlarr.UserAgentStatistics) {
+	u.UserAgent = types.StringValue(stats.GetUserAgent())
+	u.NumberOfQueries = types.Int64Value(int64(stats.GetNumberOfQueries()))
+	u.NumberOfGrabs = types.Int64Value(int64(stats.GetNumberOfGrabs()))
+}
+
+func (h *HostStatistics) write(stats *prowlarr.HostStatistics) {
+	h.Host = types.StringValue(stats.GetHost())
+	h.NumberOfQueries = types.Int64Value(int64(stats.GetNumberOfQueries()))
+	h.NumberOfGrabs = types.Int64Value(int64(stats.GetNumberOfGrabs()))
+}
+
+// parseStatsDate parses an optional RFC3339 date, reporting invalid ones on the attribute.
+func parseStatsDate(value types.String, attribute path.Path, diags *diag.Diagnostics) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attribute, helpers.DataSourceError, fmt.Sprintf("Unable to parse %s as RFC3339 date, got error: %s", attribute, err))
+
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+// joinInt64Set returns the set values as comma separated list, as expected by query filters.
+func joinInt64Set(ctx context.Context, set types.Set, diags *diag.Diagnostics) string {
+	var values []int64
+
+	diags.Append(set.ElementsAs(ctx, &values, true)...)
+
+	items := make([]string, len(values))
+	for n, v := range values {
+		items[n] = strconv.FormatInt(v, 10)
+	}
+
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccIndexerStatsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccIndexerStatsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      `data "prowlarr_indexer_stats" "test" { start_date = "yesterday" }`,
+				ExpectError: regexp.MustCompile("Unable to parse start_date"),
+			},
+			// Read testing
+			{
+				Config: testAccIndexerStatsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prowlarr_indexer_stats.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIndexerStatsDataSourceConfig = `
+data "prowlarr_indexer_stats" "test" {
+	start_date = "2024-01-01T00:00:00Z"
+	end_date = "2024-01-31T23:59:59Z"
+}
+`
+
+func TestIndexerStatsReadWrite(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "2024-01-01T00:00:00Z", query.Get("startDate"))
+		assert.Empty(t, query.Get("endDate"))
+		assert.Equal(t, "1,2", query.Get("indexers"))
+		assert.Empty(t, query.Get("tags"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"indexers":[{"indexerId":1,"indexerName":"Test","averageResponseTime":120,"numberOfQueries":10,"numberOfFailedQueries":2}],
+			"userAgents":[{"userAgent":"Sonarr","numberOfQueries":10,"numberOfGrabs":3}],
+			"hosts":[{"host":"localhost","numberOfQueries":10}]
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+
+	indexerIDs, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{1, 2})
+	stats := IndexerStats{
+		IndexerIDs: indexerIDs,
+		Tags:       types.SetNull(types.Int64Type),
+		StartDate:  types.StringValue("2024-01-01T00:00:00Z"),
+		EndDate:    types.StringNull(),
+	}
+
+	var diags diag.Diagnostics
+
+	response, _, err := stats.read(ctx, client.IndexerStatsAPI.GetIndexerStats(newAuthContext(apiURL, "key")), &diags).Execute()
+	assert.NoError(t, err)
+	assert.False(t, diags.HasError())
+
+	stats.write(ctx, response, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "1", stats.ID.ValueString())
+
+	var indexers []IndexerStatistics
+
+	diags.Append(stats.Indexers.ElementsAs(ctx, &indexers, false)...)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Test", indexers[0].IndexerName.ValueString())
+	assert.Equal(t, int64(120), indexers[0].AverageResponseTime.ValueInt64())
+	assert.Equal(t, int64(2), indexers[0].NumberOfFailedQueries.ValueInt64())
+	assert.Equal(t, 1, len(stats.UserAgents.Elements()))
+	assert.Equal(t, 1, len(stats.Hosts.Elements()))
+}
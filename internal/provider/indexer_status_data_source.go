@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerStatusDataSourceName = "indexer_status"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerStatusDataSource{}
+
+func NewIndexerStatusDataSource() datasource.DataSource {
+	return &IndexerStatusDataSource{}
+}
+
+// IndexerStatusDataSource defines the indexer status implementation.
+type IndexerStatusDataSource struct {
+	client *prowlarr.APIClient
+	auth   context.Context
+}
+
+// IndexerStatus describes the indexer status data model.
+type IndexerStatus struct {
+	DisabledTill      types.String `tfsdk:"disabled_till"`
+	MostRecentFailure types.String `tfsdk:"most_recent_failure"`
+	InitialFailure    types.String `tfsdk:"initial_failure"`
+	IndexerID         types.Int64  `tfsdk:"indexer_id"`
+	ID                types.Int64  `tfsdk:"id"`
+	Disabled          types.Bool   `tfsdk:"disabled"`
+}
+
+func (i IndexerStatus) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"disabled_till":       types.StringType,
+			"most_recent_failure": types.StringType,
+			"initial_failure":     types.StringType,
+			"indexer_id":          types.Int64Type,
+			"id":                  types.Int64Type,
+			"disabled":            types.BoolType,
+		})
+}
+
+func (d *IndexerStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerStatusDataSourceName
+}
+
+func (d *IndexerStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nSingle [Indexer](../resources/indexer) status.\nProwlarr disables failing indexers with a back-off, an indexer without failures has no failure times and is not disabled. A missing indexer is reported as an error.",
+		Attributes: map[string]schema.Attribute{
+			"indexer_id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer status ID, `0` if the indexer has no failures.",
+				Computed:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Disabled flag, true while the indexer is disabled by the failure back-off.",
+				Computed:            true,
+			},
+			"disabled_till": schema.StringAttribute{
+				MarkdownDescription: "Time until the indexer is disabled.",
+				Computed:            true,
+			},
+			"most_recent_failure": schema.StringAttribute{
+				MarkdownDescription: "Most recent failure time.",
+				Computed:            true,
+			},
+			"initial_failure": schema.StringAttribute{
+				MarkdownDescription: "Initial failure time.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *IndexerStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *IndexerStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *IndexerStatus
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check that the indexer exists, since indexers without failures have no status
+	indexerID := int32(data.IndexerID.ValueInt64())
+	if _, httpResp, err := d.client.IndexerAPI.GetIndexerById(d.auth, indexerID).Execute(); err != nil {
+		if helpers.IsNotFoundError(httpResp, err) {
+			resp.Diagnostics.AddAttributeError(path.Root("indexer_id"), helpers.DataSourceError, helpers.ParseNotFoundError(indexerResourceName, "ID", strconv.Itoa(int(indexerID))))
+		} else {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerStatusDataSourceName, err))
+		}
+
+		return
+	}
+
+	// Get indexer statuses current value
+	response, _, err := d.client.IndexerStatusAPI.ListIndexerStatus(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerStatusDataSourceName, err))
+
+		return
+	}
+
+	data.find(indexerID, response)
+	tflog.Trace(ctx, "read "+indexerStatusDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (i *IndexerStatus) write(status *prowlarr.IndexerStatusResource) {
+	i.ID = types.Int64Value(int64(status.GetId()))
+	i.IndexerID = types.Int64Value(int64(status.GetIndexerId()))
+	i.DisabledTill = types.StringValue(formatNullableTime(status.DisabledTill))
+	i.MostRecentFailure = types.StringValue(formatNullableTime(status.MostRecentFailure))
+	i.InitialFailure = types.StringValue(formatNullableTime(status.InitialFailure))
+	i.Disabled = types.BoolValue(status.DisabledTill.Get() != nil && status.DisabledTill.Get().After(time.Now()))
+}
+
+// find writes the status of the given indexer, Prowlarr only stores statuses of indexers with failures.
+func (i *IndexerStatus) find(indexerID int32, statuses []prowlarr.IndexerStatusResource) {
+	for _, status := range statuses {
+		if status.GetIndexerId() == indexerID {
+			i.write(&status)
+
+			return
+		}
+	}
+
+	status := prowlarr.NewIndexerStatusResource()
+	status.SetIndexerId(indexerID)
+	i.write(status)
+}
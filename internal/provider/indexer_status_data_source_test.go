@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccIndexerStatusDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccIndexerResourceConfig("statusDataTest", "https://0magnet.co/") + testAccIndexerStatusDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      `data "prowlarr_indexer_status" "test" { indexer_id = 999999 }`,
+				ExpectError: regexp.MustCompile("Unable to find indexer"),
+			},
+			// Read testing
+			{
+				Config: testAccIndexerResourceConfig("statusDataTest", "https://0magnet.co/") + testAccIndexerStatusDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.prowlarr_indexer_status.test", "indexer_id", "prowlarr_indexer.test", "id"),
+					resource.TestCheckResourceAttr("data.prowlarr_indexer_status.test", "disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIndexerStatusDataSourceConfig = `
+data "prowlarr_indexer_status" "test" {
+	indexer_id = prowlarr_indexer.test.id
+}
+`
+
+func TestIndexerStatusFind(t *testing.T) {
+	t.Parallel()
+
+	disabledTill := time.Now().Add(time.Hour)
+	expiredTill := time.Now().Add(-time.Hour)
+
+	statuses := []prowlarr.IndexerStatusResource{
+		{Id: prowlarr.PtrInt32(1), IndexerId: prowlarr.PtrInt32(10), DisabledTill: *prowlarr.NewNullableTime(&disabledTill)},
+		{Id: prowlarr.PtrInt32(2), IndexerId: prowlarr.PtrInt32(20), DisabledTill: *prowlarr.NewNullableTime(&expiredTill)},
+	}
+
+	tests := map[string]struct {
+		indexerID int32
+		id        int64
+		disabled  bool
+	}{
+		"disabled": {
+			indexerID: 10,
+			id:        1,
+			disabled:  true,
+		},
+		"expired": {
+			indexerID: 20,
+			id:        2,
+			disabled:  false,
+		},
+		"no failures": {
+			indexerID: 30,
+			id:        0,
+			disabled:  false,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var status IndexerStatus
+
+			status.find(test.indexerID, statuses)
+			assert.Equal(t, int64(test.indexerID), status.IndexerID.ValueInt64())
+			assert.Equal(t, test.id, status.ID.ValueInt64())
+			assert.Equal(t, test.disabled, status.Disabled.ValueBool())
+		})
+	}
+}
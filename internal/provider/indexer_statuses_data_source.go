@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerStatusesDataSourceName = "indexer_statuses"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerStatusesDataSource{}
+
+func NewIndexerStatusesDataSource() datasource.DataSource {
+	return &IndexerStatusesDataSource{}
+}
+
+// IndexerStatusesDataSource defines the indexer statuses implementation.
+type IndexerStatusesDataSource struct {
+	client *prowlarr.APIClient
+	auth   context.Context
+}
+
+// IndexerStatuses describes the indexer statuses data model.
+type IndexerStatuses struct {
+	IndexerStatuses types.Set    `tfsdk:"indexer_statuses"`
+	ID              types.String `tfsdk:"id"`
+}
+
+func (d *IndexerStatusesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerStatusesDataSourceName
+}
+
+func (d *IndexerStatusesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nList all [Indexer](../resources/indexer) statuses.\nProwlarr only lists indexers with failures, disabling them with a back-off.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"indexer_statuses": schema.SetNestedAttribute{
+				MarkdownDescription: "Indexer status list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"indexer_id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer ID.",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer status ID.",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Disabled flag, true while the indexer is disabled by the failure back-off.",
+							Computed:            true,
+						},
+						"disabled_till": schema.StringAttribute{
+							MarkdownDescription: "Time until the indexer is disabled.",
+							Computed:            true,
+						},
+						"most_recent_failure": schema.StringAttribute{
+							MarkdownDescription: "Most recent failure time.",
+							Computed:            true,
+						},
+						"initial_failure": schema.StringAttribute{
+							MarkdownDescription: "Initial failure time.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IndexerStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *IndexerStatusesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get indexer statuses current value
+	response, _, err := d.client.IndexerStatusAPI.ListIndexerStatus(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerStatusesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerStatusesDataSourceName)
+	// Map response body to resource schema attribute
+	statuses := make([]IndexerStatus, len(response))
+	for i, s := range response {
+		statuses[i].write(&s)
+	}
+
+	statusList, diags := types.SetValueFrom(ctx, IndexerStatus{}.getType(), statuses)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, IndexerStatuses{IndexerStatuses: statusList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
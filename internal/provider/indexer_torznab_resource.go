@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	indexerTorznabResourceName   = "indexer_torznab"
+	indexerTorznabImplementation = "Torznab"
+	indexerTorznabConfigContract = "TorznabSettings"
+	indexerTorznabProtocol       = "torrent"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IndexerTorznabResource{}
+	_ resource.ResourceWithImportState = &IndexerTorznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorznabResource{}
+)
+
+func NewIndexerTorznabResource() resource.Resource {
+	return &IndexerTorznabResource{}
+}
+
+// IndexerTorznabResource defines the indexer implementation.
+type IndexerTorznabResource struct {
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
+}
+
+// IndexerTorznab describes the indexer data model.
+type IndexerTorznab struct {
+	Tags                 types.Set     `tfsdk:"tags"`
+	TagsAll              types.Set     `tfsdk:"tags_all"`
+	Name                 types.String  `tfsdk:"name"`
+	Language             types.String  `tfsdk:"language"`
+	Privacy              types.String  `tfsdk:"privacy"`
+	BaseURL              types.String  `tfsdk:"base_url"`
+	APIPath              types.String  `tfsdk:"api_path"`
+	APIKey               types.String  `tfsdk:"api_key"`
+	AdditionalParameters types.String  `tfsdk:"additional_parameters"`
+	VipExpiration        types.String  `tfsdk:"vip_expiration"`
+	SeedRatio            types.Float64 `tfsdk:"seed_ratio"`
+	AppProfileID         types.Int64   `tfsdk:"app_profile_id"`
+	Priority             types.Int64   `tfsdk:"priority"`
+	QueryLimit           types.Int64   `tfsdk:"query_limit"`
+	GrabLimit            types.Int64   `tfsdk:"grab_limit"`
+	LimitsUnit           types.Int64   `tfsdk:"limits_unit"`
+	MinimumSeeders       types.Int64   `tfsdk:"minimum_seeders"`
+	SeedTime             types.Int64   `tfsdk:"seed_time"`
+	PackSeedTime         types.Int64   `tfsdk:"pack_seed_time"`
+	ID                   types.Int64   `tfsdk:"id"`
+	Enable               types.Bool    `tfsdk:"enable"`
+	ForceSave            types.Bool    `tfsdk:"force_save"`
+	PreferMagnetURL      types.Bool    `tfsdk:"prefer_magnet_url"`
+}
+
+func (i IndexerTorznab) toIndexer(ctx context.Context, diags *diag.Diagnostics) *Indexer {
+	indexer := Indexer{
+		Tags:           i.Tags,
+		Name:           i.Name,
+		Language:       i.Language,
+		Privacy:        i.Privacy,
+		AppProfileID:   i.AppProfileID,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		Enable:         i.Enable,
+		Implementation: types.StringValue(indexerTorznabImplementation),
+		ConfigContract: types.StringValue(indexerTorznabConfigContract),
+		Protocol:       types.StringValue(indexerTorznabProtocol),
+	}
+
+	indexer.setFields(ctx, []Field{
+		newTextField("baseUrl", i.BaseURL),
+		newTextField("apiPath", i.APIPath),
+		newSensitiveField("apiKey", i.APIKey),
+		newTextField("additionalParameters", i.AdditionalParameters),
+		newTextField("vipExpiration", i.VipExpiration),
+		newInt64Field("baseSettings.queryLimit", i.QueryLimit),
+		newInt64Field("baseSettings.grabLimit", i.GrabLimit),
+		newInt64Field("baseSettings.limitsUnit", i.LimitsUnit),
+		newInt64Field("torrentBaseSettings.appMinimumSeeders", i.MinimumSeeders),
+		newFloat64Field("torrentBaseSettings.seedRatio", i.SeedRatio),
+		newInt64Field("torrentBaseSettings.seedTime", i.SeedTime),
+		newInt64Field("torrentBaseSettings.packSeedTime", i.PackSeedTime),
+		newBoolField("torrentBaseSettings.preferMagnetUrl", i.PreferMagnetURL),
+	}, diags)
+
+	return &indexer
+}
+
+func (i *IndexerTorznab) fromIndexer(ctx context.Context, indexer *Indexer, diags *diag.Diagnostics) {
+	i.Tags = indexer.Tags
+	i.Name = indexer.Name
+	i.Language = indexer.Language
+	i.Privacy = indexer.Privacy
+	i.AppProfileID = indexer.AppProfileID
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Enable = indexer.Enable
+
+	fields := indexer.getFieldMap(ctx, diags)
+	i.BaseURL = fields["baseUrl"].stringValue()
+	i.APIPath = fields["apiPath"].stringValue()
+	i.APIKey = fields["apiKey"].stringValue()
+	i.AdditionalParameters = fields["additionalParameters"].stringValue()
+	i.VipExpiration = fields["vipExpiration"].stringValue()
+	i.QueryLimit = fields["baseSettings.queryLimit"].int64Value()
+	i.GrabLimit = fields["baseSettings.grabLimit"].int64Value()
+	i.LimitsUnit = fields["baseSettings.limitsUnit"].int64Value()
+	i.MinimumSeeders = fields["torrentBaseSettings.appMinimumSeeders"].int64Value()
+	i.SeedRatio = fields["torrentBaseSettings.seedRatio"].float64Value()
+	i.SeedTime = fields["torrentBaseSettings.seedTime"].int64Value()
+	i.PackSeedTime = fields["torrentBaseSettings.packSeedTime"].int64Value()
+	i.PreferMagnetURL = fields["torrentBaseSettings.preferMagnetUrl"].BoolValue
+}
+
+func (r *IndexerTorznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerTorznabResourceName
+}
+
+func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Torznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/prowlarr/indexers) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Application profile ID.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Indexer name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language.",
+				Computed:            true,
+			},
+			"privacy": schema.StringAttribute{
+				MarkdownDescription: "Privacy.",
+				Computed:            true,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the indexer without testing it first, as Prowlarr `forceSave` does. Otherwise enabled indexers are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL.",
+				Required:            true,
+			},
+			"api_path": schema.StringAttribute{
+				MarkdownDescription: "API path.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
+			},
+			"additional_parameters": schema.StringAttribute{
+				MarkdownDescription: "Additional parameters.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"vip_expiration": schema.StringAttribute{
+				MarkdownDescription: "VIP expiration date.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"query_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of queries per limits unit.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"grab_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of grabs per limits unit.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"limits_unit": schema.Int64Attribute{
+				MarkdownDescription: "Limits unit. `0` Day, `1` Hour.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1),
+				},
+			},
+			"minimum_seeders": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of seeders required by the applications.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_ratio": schema.Float64Attribute{
+				MarkdownDescription: "Seed ratio.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Seed time in minutes.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pack_seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Season pack seed time in minutes.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"prefer_magnet_url": schema.BoolAttribute{
+				MarkdownDescription: "Prefer magnet URL flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *IndexerTorznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+	}
+}
+
+func (r *IndexerTorznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
+func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var indexer *IndexerTorznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new IndexerTorznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !indexer.ForceSave.ValueBool() && indexerEnabled(request) {
+		if _, err := r.client.IndexerAPI.TestIndexer(r.auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, indexerTorznabResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).ForceSave(indexer.ForceSave.ValueBool()).IndexerResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, indexerTorznabResourceName, err)
+
+		return
+	}
+
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var indexer *IndexerTorznab
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get IndexerTorznab current value
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, indexerTorznabResourceName, httpResp, err, resp)
+
+		return
+	}
+
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var indexer *IndexerTorznab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update IndexerTorznab
+	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !indexer.ForceSave.ValueBool() && indexerEnabled(request) {
+		if _, err := r.client.IndexerAPI.TestIndexer(r.auth).IndexerResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, indexerTorznabResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(indexer.ForceSave.ValueBool()).IndexerResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, indexerTorznabResourceName, err)
+
+		return
+	}
+
+	response.Tags, indexer.TagsAll = r.defaultTags.split(ctx, response.Tags, indexer.Tags, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerTorznabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete IndexerTorznab current value
+	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorznabResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+indexerTorznabResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IndexerTorznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateLookupIntID(ctx, indexerTorznabResourceName, path.Root("id"), req, resp, indexerImportLookup(r.auth, r.client, indexerTorznabImplementation))
+	tflog.Trace(ctx, "imported "+indexerTorznabResourceName+": "+req.ID)
+}
+
+func (i *IndexerTorznab) write(ctx context.Context, indexer *prowlarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer(ctx, diags)
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(ctx, genericIndexer, diags)
+}
+
+func (i *IndexerTorznab) read(ctx context.Context, diags *diag.Diagnostics) *prowlarr.IndexerResource {
+	return i.toIndexer(ctx, diags).read(ctx, diags)
+}
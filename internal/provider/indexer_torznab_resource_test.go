@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerTorznabResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerTorznabResourceConfig("resourceTorznabTest", 10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerTorznabResourceConfig("resourceTorznabTest", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_torznab.test", "query_limit", "10"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_torznab.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerTorznabResourceConfig("resourceTorznabTest", 10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerTorznabResourceConfig("resourceTorznabTest", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_torznab.test", "query_limit", "20"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "prowlarr_indexer_torznab.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIndexerTorznabResourceForceSave(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Failing test on create
+			{
+				Config:      testAccIndexerTorznabResourceForceSaveConfig("resourceTorznabForceSaveTest", false),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create skipping the test
+			{
+				Config: testAccIndexerTorznabResourceForceSaveConfig("resourceTorznabForceSaveTest", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("prowlarr_indexer_torznab.test", "force_save", "true"),
+					resource.TestCheckResourceAttrSet("prowlarr_indexer_torznab.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerTorznabResourceConfig(name string, limit int) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_torznab" "test" {
+		enable = false
+		name = "%s"
+		app_profile_id = 1
+
+		base_url = "https://torznab.example.com"
+		api_path = "/api"
+		api_key = "APIKey"
+		query_limit = %d
+		limits_unit = 0
+		seed_ratio = 0.5
+		seed_time = 60
+		prefer_magnet_url = false
+	}`, name, limit)
+}
+
+func testAccIndexerTorznabResourceForceSaveConfig(name string, force bool) string {
+	return fmt.Sprintf(`
+	resource "prowlarr_indexer_torznab" "test" {
+		enable = true
+		force_save = %t
+		name = "%s"
+		app_profile_id = 1
+
+		base_url = "http://127.0.0.1:1"
+		api_path = "/api"
+		api_key = "APIKey"
+	}`, force, name)
+}
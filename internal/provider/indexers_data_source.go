@@ -128,6 +128,11 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 										Computed:            true,
 										ElementType:         types.Int64Type,
 									},
+									"string_set_value": schema.SetAttribute{
+										MarkdownDescription: "String set value.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
 								},
 							},
 						},
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// List cache keys are the API paths of the cached list endpoints.
+const (
+	applicationListPath    = "/api/v1/applications"
+	downloadClientListPath = "/api/v1/downloadclient"
+	indexerListPath        = "/api/v1/indexer"
+	indexerProxyListPath   = "/api/v1/indexerproxy"
+	notificationListPath   = "/api/v1/notification"
+	syncProfileListPath    = "/api/v1/appprofile"
+)
+
+// ListCache shares the list endpoint responses among data sources, so that each
+// list endpoint is called once per run. Mutating requests invalidate the list of their API path,
+// or the whole cache for other paths, so that data sources read after a resource change see the new objects.
+type ListCache struct {
+	entries map[string]*listCacheEntry
+	mu      sync.Mutex
+}
+
+type listCacheEntry struct {
+	value any
+	mu    sync.Mutex
+	valid bool
+}
+
+// NewListCache returns an empty list cache.
+func NewListCache() *ListCache {
+	return &ListCache{entries: make(map[string]*listCacheEntry)}
+}
+
+func (c *ListCache) entry(key string) *listCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = &listCacheEntry{}
+	}
+
+	return c.entries[key]
+}
+
+// invalidate removes the list of the given API path (e.g. `/api/v1/indexer/1`),
+// or all of them if the path is not under a cached list. The URL base, if any, is ignored.
+func (c *ListCache) invalidate(apiPath string) {
+	if index := strings.Index(apiPath, "/api/"); index > 0 {
+		apiPath = apiPath[index:]
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if apiPath == key || strings.HasPrefix(apiPath, key+"/") {
+			delete(c.entries, key)
+
+			return
+		}
+	}
+
+	c.entries = make(map[string]*listCacheEntry)
+}
+
+// Transport returns a transport invalidating the cache after every mutating request.
+func (c *ListCache) Transport(transport http.RoundTripper) http.RoundTripper {
+	return &listCacheTransport{transport: transport, cache: c}
+}
+
+type listCacheTransport struct {
+	transport http.RoundTripper
+	cache     *ListCache
+}
+
+func (t *listCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		defer t.cache.invalidate(req.URL.Path)
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// cachedList returns the cached response of a list endpoint, calling it only on cache miss.
+// The key is the API path of the list endpoint. Concurrent calls for the same key wait for the first one, errors are not cached.
+// The HTTP response is only returned on cache miss.
+func cachedList[T any](cache *ListCache, key string, list func() ([]T, *http.Response, error)) ([]T, *http.Response, error) {
+	if cache == nil {
+		return list()
+	}
+
+	entry := cache.entry(key)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if value, ok := entry.value.([]T); ok && entry.valid {
+		return value, nil, nil
+	}
+
+	response, httpResp, err := list()
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	entry.value = response
+	entry.valid = true
+
+	return response, httpResp, nil
+}
+
+// dataSourceListCache returns the provider list cache for a specific data source.
+func dataSourceListCache(req datasource.ConfigureRequest) *ListCache {
+	if providerData, ok := req.ProviderData.(*ProwlarrData); ok {
+		return providerData.ListCache
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedList(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	var calls atomic.Int32
+
+	list := func() ([]string, *http.Response, error) {
+		calls.Add(1)
+
+		return []string{"first", "second"}, nil, nil
+	}
+
+	cache := NewListCache()
+	client := &http.Client{Transport: cache.Transport(http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			response, _, err := cachedList(cache, "test", list)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"first", "second"}, response)
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Reads do not clear the cache.
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	_, _, _ = cachedList(cache, "test", list)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Writes clear the cache.
+	resp, err = client.Post(server.URL, "application/json", nil)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	_, _, _ = cachedList(cache, "test", list)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// No cache.
+	_, _, _ = cachedList(nil, "test", list)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestListCacheInvalidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path    string
+		indexer bool
+		proxy   bool
+	}{
+		"list path": {
+			path:  "/api/v1/indexer",
+			proxy: true,
+		},
+		"item path": {
+			path:  "/api/v1/indexer/1",
+			proxy: true,
+		},
+		"url base": {
+			path:    "/prowlarr/api/v1/indexerproxy/2",
+			indexer: true,
+		},
+		"other path": {
+			path: "/api/v1/tag",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cache := NewListCache()
+			list := func() ([]string, *http.Response, error) { return []string{"item"}, nil, nil }
+
+			_, _, _ = cachedList(cache, indexerListPath, list)
+			_, _, _ = cachedList(cache, indexerProxyListPath, list)
+
+			cache.invalidate(test.path)
+
+			_, indexer := cache.entries[indexerListPath]
+			_, proxy := cache.entries[indexerProxyListPath]
+			assert.Equal(t, test.indexer, indexer)
+			assert.Equal(t, test.proxy, proxy)
+		})
+	}
+}
@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -36,13 +37,15 @@ func NewNotificationAppriseResource() resource.Resource {
 
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // NotificationApprise describes the notification data model.
 type NotificationApprise struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	FieldTags             types.Set    `tfsdk:"field_tags"`
 	ConfigurationKey      types.String `tfsdk:"configuration_key"`
 	StatelessURLs         types.String `tfsdk:"stateless_urls"`
@@ -58,6 +61,7 @@ type NotificationApprise struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
@@ -150,6 +154,15 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the notification without testing it first, as Prowlarr `forceSave` does. Otherwise enabled notifications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -186,12 +199,18 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"configuration_key": schema.StringAttribute{
 				MarkdownDescription: "ConfigurationKey.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"field_tags": schema.SetAttribute{
 				MarkdownDescription: "Tags and emojis.",
@@ -207,9 +226,14 @@ func (r *NotificationAppriseResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *NotificationAppriseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationApprise
@@ -222,14 +246,30 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationAppriseResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, notificationAppriseResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -247,13 +287,15 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationApprise current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
+		helpers.HandleReadError(ctx, notificationAppriseResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -272,14 +314,30 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationAppriseResourceName, err)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, notificationAppriseResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -308,7 +366,7 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, notificationAppriseResourceName, path.Root("id"), req, resp, notificationImportLookup(r.auth, r.client, notificationAppriseImplementation))
 	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
 }
 
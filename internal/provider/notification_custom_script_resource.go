@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationCustomScriptResource{}
 	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -35,13 +36,15 @@ func NewNotificationCustomScriptResource() resource.Resource {
 
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Arguments             types.String `tfsdk:"arguments"`
 	Path                  types.String `tfsdk:"path"`
 	Name                  types.String `tfsdk:"name"`
@@ -50,6 +53,7 @@ type NotificationCustomScript struct {
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
 }
 
 func (n NotificationCustomScript) toNotification() *Notification {
@@ -117,6 +121,15 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the notification without testing it first, as Prowlarr `forceSave` does. Otherwise enabled notifications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -142,9 +155,14 @@ func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req re
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *NotificationCustomScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationCustomScript
@@ -157,14 +175,30 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 
 	// Create new NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationCustomScriptResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, notificationCustomScriptResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -182,13 +216,15 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	}
 
 	// Get NotificationCustomScript current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
+		helpers.HandleReadError(ctx, notificationCustomScriptResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -207,14 +243,30 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationCustomScriptResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, notificationCustomScriptResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -243,7 +295,7 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, notificationCustomScriptResourceName, path.Root("id"), req, resp, notificationImportLookup(r.auth, r.client, notificationCustomScriptImplementation))
 	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
 }
 
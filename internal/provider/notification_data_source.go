@@ -26,6 +26,7 @@ func NewNotificationDataSource() datasource.DataSource {
 type NotificationDataSource struct {
 	client *prowlarr.APIClient
 	auth   context.Context
+	cache  *ListCache
 }
 
 func (d *NotificationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -400,6 +401,7 @@ func (d *NotificationDataSource) Configure(ctx context.Context, req datasource.C
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.cache = dataSourceListCache(req)
 	}
 }
 
@@ -412,7 +414,7 @@ func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get notification current value
-	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
+	response, _, err := cachedList(d.cache, notificationListPath, d.client.NotificationAPI.ListNotification(d.auth).Execute)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDataSourceName, err))
 
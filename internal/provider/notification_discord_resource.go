@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationDiscordResource{}
 	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationDiscordResource{}
 )
 
 func NewNotificationDiscordResource() resource.Resource {
@@ -34,13 +35,15 @@ func NewNotificationDiscordResource() resource.Resource {
 
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // NotificationDiscord describes the notification data model.
 type NotificationDiscord struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	GrabFields            types.Set    `tfsdk:"grab_fields"`
 	WebHookURL            types.String `tfsdk:"web_hook_url"`
 	Name                  types.String `tfsdk:"name"`
@@ -54,6 +57,7 @@ type NotificationDiscord struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
 }
 
 func (n NotificationDiscord) toNotification() *Notification {
@@ -142,6 +146,15 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the notification without testing it first, as Prowlarr `forceSave` does. Otherwise enabled notifications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -183,9 +196,14 @@ func (r *NotificationDiscordResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *NotificationDiscordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationDiscord
@@ -198,14 +216,30 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 
 	// Create new NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationDiscordResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, notificationDiscordResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -223,13 +257,15 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationDiscord current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDiscordResourceName, err))
+		helpers.HandleReadError(ctx, notificationDiscordResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -248,14 +284,30 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationDiscordResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, notificationDiscordResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -284,7 +336,7 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, notificationDiscordResourceName, path.Root("id"), req, resp, notificationImportLookup(r.auth, r.client, notificationDiscordImplementation))
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
 
@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmailResource{}
 	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -36,13 +37,15 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // NotificationEmail describes the notification data model.
 type NotificationEmail struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	To                    types.Set    `tfsdk:"to"`
 	Cc                    types.Set    `tfsdk:"cc"`
 	Bcc                   types.Set    `tfsdk:"bcc"`
@@ -60,6 +63,7 @@ type NotificationEmail struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
@@ -156,6 +160,15 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the notification without testing it first, as Prowlarr `forceSave` does. Otherwise enabled notifications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -191,6 +204,9 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"from": schema.StringAttribute{
 				MarkdownDescription: "From.",
@@ -221,9 +237,14 @@ func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *NotificationEmailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmail
@@ -236,14 +257,30 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationEmailResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, notificationEmailResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -261,13 +298,15 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
+		helpers.HandleReadError(ctx, notificationEmailResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -286,14 +325,30 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationEmailResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, notificationEmailResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -322,7 +377,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, notificationEmailResourceName, path.Root("id"), req, resp, notificationImportLookup(r.auth, r.client, notificationEmailImplementation))
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
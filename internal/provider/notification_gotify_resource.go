@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationGotifyResource{}
 	_ resource.ResourceWithImportState = &NotificationGotifyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationGotifyResource{}
 )
 
 func NewNotificationGotifyResource() resource.Resource {
@@ -36,13 +37,15 @@ func NewNotificationGotifyResource() resource.Resource {
 
 // NotificationGotifyResource defines the notification implementation.
 type NotificationGotifyResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // NotificationGotify describes the notification data model.
 type NotificationGotify struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagsAll               types.Set    `tfsdk:"tags_all"`
 	Server                types.String `tfsdk:"server"`
 	Name                  types.String `tfsdk:"name"`
 	AppToken              types.String `tfsdk:"app_token"`
@@ -54,6 +57,7 @@ type NotificationGotify struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
+	ForceSave             types.Bool   `tfsdk:"force_save"`
 }
 
 func (n NotificationGotify) toNotification() *Notification {
@@ -138,6 +142,15 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the notification without testing it first, as Prowlarr `forceSave` does. Otherwise enabled notifications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -162,6 +175,9 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 				MarkdownDescription: "App token.",
 				Required:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 		},
 	}
@@ -171,9 +187,14 @@ func (r *NotificationGotifyResource) Configure(ctx context.Context, req resource
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *NotificationGotifyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationGotify
@@ -186,14 +207,30 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 
 	// Create new NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationGotifyResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationGotifyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, notificationGotifyResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -211,13 +248,15 @@ func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get NotificationGotify current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationGotifyResourceName, err))
+		helpers.HandleReadError(ctx, notificationGotifyResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -236,14 +275,30 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 
 	// Update NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationGotifyResourceName, err)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+			return
+		}
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationGotifyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, notificationGotifyResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
@@ -272,7 +327,7 @@ func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationGotifyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, notificationGotifyResourceName, path.Root("id"), req, resp, notificationImportLookup(r.auth, r.client, notificationGotifyImplementation))
 	tflog.Trace(ctx, "imported "+notificationGotifyResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const notificationResourceName = "notification"
 var (
 	_ resource.Resource                = &NotificationResource{}
 	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationResource{}
 )
 
 var notificationFields = helpers.Fields{
@@ -44,8 +45,9 @@ func NewNotificationResource() resource.Resource {
 
 // NotificationResource defines the notification implementation.
 type NotificationResource struct {
-	client *prowlarr.APIClient
-	auth   context.Context
+	client      *prowlarr.APIClient
+	auth        context.Context
+	defaultTags *DefaultTags
 }
 
 // Notification describes the notification data model.
@@ -137,6 +139,14 @@ type Notification struct {
 	IncludeManualGrabs    types.Bool   `tfsdk:"include_manual_grabs"`
 }
 
+// NotificationResourceData adds the resource only attributes to the notification data model,
+// which is shared with the data sources.
+type NotificationResourceData struct {
+	Notification
+	TagsAll   types.Set  `tfsdk:"tags_all"`
+	ForceSave types.Bool `tfsdk:"force_save"`
+}
+
 func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -283,6 +293,15 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tags_all": schema.SetAttribute{
+				MarkdownDescription: "List of all tags, including the provider `default_tags`.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"force_save": schema.BoolAttribute{
+				MarkdownDescription: "Save the notification without testing it first, as Prowlarr `forceSave` does. Otherwise enabled notifications are tested before create and update, failing with the test messages. Defaults to `false`.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -448,12 +467,18 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"configuration_key": schema.StringAttribute{
 				MarkdownDescription: "Configuration key.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"instance_name": schema.StringAttribute{
 				MarkdownDescription: "Instance name.",
@@ -525,6 +550,9 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"path": schema.StringAttribute{
 				MarkdownDescription: "Path.",
@@ -551,6 +579,9 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					helpers.ReplaceMasked(),
+				},
 			},
 			"receiver_id": schema.StringAttribute{
 				MarkdownDescription: "Receiver ID.",
@@ -695,12 +726,17 @@ func (r *NotificationResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
 	}
 }
 
+func (r *NotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.defaultTags.modifyPlan(ctx, req, resp)
+}
+
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var notification *Notification
+	var notification *NotificationResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -710,27 +746,43 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Create new Notification
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationResourceName, err)
+
+			return
+		}
+	}
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, notificationResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	state := NotificationResourceData{ForceSave: notification.ForceSave}
 
-	state.writeSensitive(notification)
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var notification *Notification
+	var notification *NotificationResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &notification)...)
 
@@ -739,26 +791,28 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get Notification current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationResourceName, err))
+		helpers.HandleReadError(ctx, notificationResourceName, httpResp, err, resp)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "read "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	state := NotificationResourceData{ForceSave: notification.ForceSave}
 
-	state.writeSensitive(notification)
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var notification *Notification
+	var notification *NotificationResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -768,20 +822,36 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Tags = r.defaultTags.merge(request.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test before saving, as Prowlarr does for enabled ones
+	if !notification.ForceSave.ValueBool() && notificationEnabled(request) {
+		if _, err := r.client.NotificationAPI.TestNotification(r.auth).NotificationResource(*request).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Validate, notificationResourceName, err)
+
+			return
+		}
+	}
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).ForceSave(notification.ForceSave.ValueBool()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, notificationResourceName, err)
 
 		return
 	}
 
+	response.Tags, notification.TagsAll = r.defaultTags.split(ctx, response.Tags, notification.Tags, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "updated "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	state := NotificationResourceData{ForceSave: notification.ForceSave}
 
-	state.writeSensitive(notification)
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -808,10 +878,28 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, notificationResourceName, path.Root("id"), req, resp, notificationImportLookup(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
 }
 
+// notificationImportLookup resolves notifications by `name:<name>` on import.
+// Typed resources pass their implementation, so that other implementations are rejected.
+func notificationImportLookup(auth context.Context, client *prowlarr.APIClient, implementation string) helpers.ImportLookup[prowlarr.NotificationResource] {
+	return helpers.ImportLookup[prowlarr.NotificationResource]{
+		Keys: map[string]func(*prowlarr.NotificationResource) string{
+			"name": (*prowlarr.NotificationResource).GetName,
+		},
+		List: func() ([]prowlarr.NotificationResource, error) {
+			response, _, err := client.NotificationAPI.ListNotification(auth).Execute()
+
+			return response, err
+		},
+		ID:             (*prowlarr.NotificationResource).GetId,
+		Implementation: (*prowlarr.NotificationResource).GetImplementation,
+		Expected:       implementation,
+	}
+}
+
 func (n *Notification) write(ctx context.Context, notification *prowlarr.NotificationResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -905,3 +993,8 @@ func (n *Notification) writeSensitive(notification *Notification) {
 		n.AuthPassword = notification.AuthPassword
 	}
 }
+
+// notificationEnabled mirrors Prowlarr, which tests only enabled notifications on save.
+func notificationEnabled(notification *prowlarr.NotificationResource) bool {
+	return notification.GetOnGrab() || notification.GetOnHealthIssue() || notification.GetOnHealthRestored() || notification.GetOnApplicationUpdate()
+}
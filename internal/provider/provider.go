@@ -2,19 +2,41 @@ package provider
 
 import (
 	"context"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/devopsarr/prowlarr-go/prowlarr"
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/helpers"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// define default values for the HTTP client.
+const (
+	defaultMaxRetries     = 3
+	defaultRetryWaitMin   = time.Second
+	defaultRetryWaitMax   = 30 * time.Second
+	defaultRequestTimeout = time.Minute
+	defaultStartupTimeout = 5 * time.Minute
+	defaultStartupPoll    = 5 * time.Second
 )
 
 // needed for tf debug mode
@@ -23,6 +45,12 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ provider.Provider = &ProwlarrProvider{}
 
+var (
+	errURLBase             = errors.New("URL base mismatch")
+	errStartupTimeout      = errors.New("Prowlarr did not become ready")
+	errStartupUnauthorized = errors.New("Prowlarr rejected the API key")
+)
+
 // ProwlarrProvider defines the provider implementation.
 type ProwlarrProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -33,9 +61,30 @@ type ProwlarrProvider struct {
 
 // Prowlarr describes the provider data model.
 type Prowlarr struct {
-	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
-	APIKey       types.String `tfsdk:"api_key"`
-	URL          types.String `tfsdk:"url"`
+	ExtraHeaders        types.Set    `tfsdk:"extra_headers"`
+	DefaultTags         types.Set    `tfsdk:"default_tags"`
+	StartupWait         types.Object `tfsdk:"startup_wait"`
+	BasicAuth           types.Object `tfsdk:"basic_auth"`
+	APIKey              types.String `tfsdk:"api_key"`
+	APIKeyFile          types.String `tfsdk:"api_key_file"`
+	ConfigXMLPath       types.String `tfsdk:"config_xml_path"`
+	URL                 types.String `tfsdk:"url"`
+	RetryWaitMin        types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax        types.String `tfsdk:"retry_wait_max"`
+	RequestTimeout      types.String `tfsdk:"request_timeout"`
+	CACertFile          types.String `tfsdk:"ca_cert_file"`
+	CACertPEM           types.String `tfsdk:"ca_cert_pem"`
+	ClientCert          types.String `tfsdk:"client_cert"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	HTTPProxy           types.String `tfsdk:"http_proxy"`
+	SchemaCacheDir      types.String `tfsdk:"schema_cache_dir"`
+	SchemaCacheTTL      types.String `tfsdk:"schema_cache_ttl"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	MaxParallelRequests types.Int64  `tfsdk:"max_parallel_requests"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
+	ReadOnly            types.Bool   `tfsdk:"read_only"`
+	SerializeWrites     types.Bool   `tfsdk:"serialize_writes"`
+	DisableListCache    types.Bool   `tfsdk:"disable_list_cache"`
 }
 
 // ExtraHeader is part of Prowlarr.
@@ -44,10 +93,26 @@ type ExtraHeader struct {
 	Value types.String `tfsdk:"value"`
 }
 
+// BasicAuth is part of Prowlarr.
+type BasicAuth struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// StartupWait is part of Prowlarr.
+type StartupWait struct {
+	Timeout  types.String `tfsdk:"timeout"`
+	Interval types.String `tfsdk:"interval"`
+}
+
 // ProwlarrData defines auth and client to be used when connecting to Prowlarr.
+// In read only mode the client rejects every mutating request, for all resources.
 type ProwlarrData struct {
-	Auth   context.Context
-	Client *prowlarr.APIClient
+	Auth        context.Context
+	Client      *prowlarr.APIClient
+	DefaultTags *DefaultTags
+	ListCache   *ListCache
+	SchemaCache *SchemaCache
 }
 
 func (p *ProwlarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -60,12 +125,20 @@ func (p *ProwlarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 		MarkdownDescription: "The Prowlarr provider is used to interact with any [Prowlarr](https://prowlarr.com/) installation. You must configure the provider with the proper credentials before you can use it. Use the left navigation to read about the available resources.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key for Prowlarr authentication. Can be specified via the `PROWLARR_API_KEY` environment variable.",
+				MarkdownDescription: "API key for Prowlarr authentication. Can be specified via the `PROWLARR_API_KEY` environment variable. Takes precedence over `api_key_file` and `config_xml_path`.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API key. Used when `api_key` is not set. Can be specified via the `PROWLARR_API_KEY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"config_xml_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the Prowlarr `config.xml` file (e.g. `/config/config.xml`) to read the API key from. Used when neither `api_key` nor `api_key_file` are set. Can be specified via the `PROWLARR_CONFIG_XML_PATH` environment variable.",
+				Optional:            true,
+			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Full Prowlarr URL with protocol and port (e.g. `https://test.prowlarr.audio:8686`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `PROWLARR_URL` environment variable.",
+				MarkdownDescription: "Full Prowlarr URL with protocol, port and URL base if any (e.g. `https://test.prowlarr.audio:8686` or `https://media.example/prowlarr` behind a reverse proxy). You should **NOT** supply the API path (`/api`), the SDK will use the appropriate paths. Can be specified via the `PROWLARR_URL` environment variable.",
 				Optional:            true,
 			},
 			"extra_headers": schema.SetNestedAttribute{
@@ -84,6 +157,120 @@ func (p *ProwlarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 					},
 				},
 			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags, by ID or label, added to every taggable resource (applications, download clients, notifications, indexers and indexer proxies). Default tags are merged into the resource `tags` sent to Prowlarr, but are not stored in the resource `tags` unless explicitly configured, so no difference is shown in them. All the tags, default ones included, are tracked in the computed resource `tags_all`, so that default tags changed here or removed outside Terraform are applied again. Labels must match existing tags. Can be specified via the `PROWLARR_DEFAULT_TAGS` environment variable as a comma separated list.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries on connection errors, 429, 5xx and `database is locked` responses. Create (POST) requests are not retried on 5xx, since they may have been applied. Defaults to `3`. Can be specified via the `PROWLARR_MAX_RETRIES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_parallel_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent requests to Prowlarr, shared by all resources and data sources. `0` means unlimited. Defaults to `0`. Can be specified via the `PROWLARR_MAX_PARALLEL_REQUESTS` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"serialize_writes": schema.BoolAttribute{
+				MarkdownDescription: "Send mutating requests (create, update, delete) one at a time, to avoid SQLite `database is locked` errors with high Terraform parallelism. Reads are not affected. Defaults to `false`. Can be specified via the `PROWLARR_SERIALIZE_WRITES` environment variable.",
+				Optional:            true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait before retrying a request, as a duration string (e.g. `1s`), not greater than `retry_wait_max`. Defaults to `1s`. Can be specified via the `PROWLARR_RETRY_WAIT_MIN` environment variable.",
+				Optional:            true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait before retrying a request, as a duration string (e.g. `30s`). Defaults to `30s`. Can be specified via the `PROWLARR_RETRY_WAIT_MAX` environment variable.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a single request, as a duration string (e.g. `1m`). `0s` means no timeout. Defaults to `1m`. Can be specified via the `PROWLARR_REQUEST_TIMEOUT` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA certificate bundle used to verify the Prowlarr certificate, in addition to the system ones. Can be specified via the `PROWLARR_CA_CERT_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate bundle used to verify the Prowlarr certificate, in addition to the system ones. Can be specified via the `PROWLARR_CA_CERT_PEM` environment variable.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS authentication. Requires `client_key`. Can be specified via the `PROWLARR_CLIENT_CERT` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key for mutual TLS authentication. Requires `client_cert`. Can be specified via the `PROWLARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Prowlarr certificate. Defaults to `false`. Can be specified via the `PROWLARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Read only mode, e.g. for pipelines running `terraform plan` only. All mutating API calls (create, update, delete) fail, while reads and data sources keep working. Defaults to `false`. Can be specified via the `PROWLARR_READ_ONLY` environment variable.",
+				Optional:            true,
+			},
+			"schema_cache_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory where the indexer schema list is cached, keyed by Prowlarr instance and version. Used by `prowlarr_indexer_schema`, `prowlarr_indexer_schemas`, `prowlarr_indexer` and `prowlarr_indexer_cardigann`, so that the list is downloaded again only when the cache expires or Prowlarr is upgraded. If unset, the list is only cached in memory and downloaded once per run. Can be specified via the `PROWLARR_SCHEMA_CACHE_DIR` environment variable.",
+				Optional:            true,
+			},
+			"schema_cache_ttl": schema.StringAttribute{
+				MarkdownDescription: "Time to live of the indexer schema cache, as a duration string (e.g. `24h`). Defaults to `24h`. Can be specified via the `PROWLARR_SCHEMA_CACHE_TTL` environment variable.",
+				Optional:            true,
+			},
+			"disable_list_cache": schema.BoolAttribute{
+				MarkdownDescription: "Disable the cache of list endpoints shared by the name lookup data sources (`prowlarr_indexer`, `prowlarr_application`, `prowlarr_download_client`, `prowlarr_notification`, `prowlarr_sync_profile` and `prowlarr_indexer_proxy`). By default each list endpoint is called once per run, and a list is called again after any change applied to its objects. Defaults to `false`. Can be specified via the `PROWLARR_DISABLE_LIST_CACHE` environment variable.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy used to reach Prowlarr (e.g. `http://proxy.local:3128`). Defaults to the standard `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables. Can be specified via the `PROWLARR_HTTP_PROXY` environment variable.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"basic_auth": schema.SingleNestedBlock{
+				MarkdownDescription: "Basic authentication credentials, e.g. for a reverse proxy in front of Prowlarr. If this block is unset, they can be specified via the `PROWLARR_BASIC_AUTH_USERNAME` and `PROWLARR_BASIC_AUTH_PASSWORD` environment variables.",
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						MarkdownDescription: "Username.",
+						Optional:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"startup_wait": schema.SingleNestedBlock{
+				MarkdownDescription: "If set, the provider waits for Prowlarr to be ready, polling its system status, before managing any resource. Useful when Prowlarr is started along with Terraform.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: "Maximum time to wait for Prowlarr, as a duration string (e.g. `5m`). Defaults to `5m`.",
+						Optional:            true,
+					},
+					"interval": schema.StringAttribute{
+						MarkdownDescription: "Time between two status checks, as a duration string (e.g. `5s`). Defaults to `5s`.",
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -97,6 +284,65 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	parsedAPIURL, key, config := getAPIConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cache list endpoints for data sources
+	var listCache *ListCache
+	if !getBool(data.DisableListCache, "PROWLARR_DISABLE_LIST_CACHE", path.Root("disable_list_cache"), &resp.Diagnostics) {
+		listCache = NewListCache()
+		config.HTTPClient.Transport = listCache.Transport(config.HTTPClient.Transport)
+	}
+
+	// Cache indexer schemas in memory and optionally on disk
+	schemaCache := NewSchemaCache(
+		getString(data.SchemaCacheDir, "PROWLARR_SCHEMA_CACHE_DIR"),
+		parsedAPIURL.Host+parsedAPIURL.Path,
+		getDuration(data.SchemaCacheTTL, "PROWLARR_SCHEMA_CACHE_TTL", defaultSchemaCacheTTL, path.Root("schema_cache_ttl"), &resp.Diagnostics),
+	)
+
+	prowlarrData := ProwlarrData{
+		Auth:        newAuthContext(parsedAPIURL, key),
+		Client:      prowlarr.NewAPIClient(config),
+		ListCache:   listCache,
+		SchemaCache: schemaCache,
+	}
+	prowlarrData.DefaultTags = newDefaultTags(prowlarrData.Auth, prowlarrData.Client, getDefaultTags(ctx, data, &resp.Diagnostics))
+
+	// Wait for Prowlarr to be ready
+	if !data.StartupWait.IsNull() {
+		timeout, interval := getStartupWait(ctx, data.StartupWait, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := prowlarrData.waitForStartup(ctx, timeout, interval); err != nil {
+			resp.Diagnostics.AddError("Prowlarr Not Ready", fmt.Sprintf("Unable to wait for Prowlarr to be ready, got error: %s", err))
+
+			return
+		}
+	}
+
+	// Check that the URL base matches the instance one
+	if urlBase := strings.TrimSuffix(parsedAPIURL.Path, "/"); urlBase != "" {
+		if err := prowlarrData.checkURLBase(urlBase); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid Prowlarr URL Base",
+				fmt.Sprintf("The URL path '%s' does not match the Prowlarr instance URL base (`url_base` in `prowlarr_host`): %s", urlBase, err))
+
+			return
+		}
+	}
+
+	resp.DataSourceData = &prowlarrData
+	resp.ResourceData = &prowlarrData
+}
+
+// getAPIConfig returns the API URL, key and client configuration, falling back to the environment variables
+// for the unset attributes. It is shared by the provider and the configuration generator.
+func getAPIConfig(ctx context.Context, data Prowlarr, diags *diag.Diagnostics) (*url.URL, string, *prowlarr.Configuration) {
 	// Extract URL
 	APIURL := data.URL.ValueString()
 	if APIURL == "" {
@@ -105,35 +351,33 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 
 	parsedAPIURL, err := url.Parse(APIURL)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unable to find valid URL",
 			"URL cannot parsed",
 		)
 
-		return
+		return nil, "", nil
 	}
 
 	// Extract key
-	key := data.APIKey.ValueString()
-	if key == "" {
-		key = os.Getenv("PROWLARR_API_KEY")
+	key := getAPIKey(ctx, data, diags)
+	if diags.HasError() {
+		return nil, "", nil
 	}
 
-	if key == "" {
-		resp.Diagnostics.AddError(
-			"Unable to find API key",
-			"API key cannot be an empty string",
-		)
-
-		return
+	// Extract HTTP client options
+	options := getClientOptions(ctx, data, diags)
+	if diags.HasError() {
+		return nil, "", nil
 	}
 
 	// Init config
 	config := prowlarr.NewConfiguration()
+	config.HTTPClient = helpers.NewHTTPClient(ctx, options)
 	// Check extra headers
 	if len(data.ExtraHeaders.Elements()) > 0 {
 		headers := make([]ExtraHeader, len(data.ExtraHeaders.Elements()))
-		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &headers, false)...)
+		diags.Append(data.ExtraHeaders.ElementsAs(ctx, &headers, false)...)
 
 		for _, header := range headers {
 			config.AddDefaultHeader(header.Name.ValueString(), header.Value.ValueString())
@@ -148,7 +392,11 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
-	// Set context for API calls
+	return parsedAPIURL, key, config
+}
+
+// newAuthContext returns the context for API calls, holding the API key and the server.
+func newAuthContext(apiURL *url.URL, key string) context.Context {
 	auth := context.WithValue(
 		context.Background(),
 		prowlarr.ContextAPIKeys,
@@ -156,17 +404,12 @@ func (p *ProwlarrProvider) Configure(ctx context.Context, req provider.Configure
 			"X-Api-Key": {Key: key},
 		},
 	)
-	auth = context.WithValue(auth, prowlarr.ContextServerVariables, map[string]string{
-		"protocol": parsedAPIURL.Scheme,
-		"hostpath": parsedAPIURL.Host,
-	})
 
-	prowlarrData := ProwlarrData{
-		Auth:   auth,
-		Client: prowlarr.NewAPIClient(config),
-	}
-	resp.DataSourceData = &prowlarrData
-	resp.ResourceData = &prowlarrData
+	// The URL path is kept to support instances behind a reverse proxy sub-path.
+	return context.WithValue(auth, prowlarr.ContextServerVariables, map[string]string{
+		"protocol": apiURL.Scheme,
+		"hostpath": apiURL.Host + strings.TrimSuffix(apiURL.Path, "/"),
+	})
 }
 
 func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -212,6 +455,9 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 
 		// Indexer
 		NewIndexerResource,
+		NewIndexerCardigannResource,
+		NewIndexerNewznabResource,
+		NewIndexerTorznabResource,
 
 		// Notifications
 		NewNotificationResource,
@@ -236,6 +482,7 @@ func (p *ProwlarrProvider) Resources(_ context.Context) []func() resource.Resour
 		NewNotificationWebhookResource,
 
 		// System
+		NewCommandResource,
 		NewHostResource,
 
 		// Tags
@@ -264,12 +511,16 @@ func (p *ProwlarrProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewIndexersDataSource,
 		NewIndexerSchemaDataSource,
 		NewIndexerSchemasDataSource,
+		NewIndexerStatusDataSource,
+		NewIndexerStatusesDataSource,
+		NewIndexerStatsDataSource,
 
 		// Notifications
 		NewNotificationDataSource,
 		NewNotificationsDataSource,
 
 		// System
+		NewHealthDataSource,
 		NewHostDataSource,
 		NewSystemStatusDataSource,
 
@@ -290,6 +541,62 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// waitForStartup polls the system status until Prowlarr responds with a valid version.
+func (p *ProwlarrData) waitForStartup(ctx context.Context, timeout, interval time.Duration) error {
+	auth, cancel := context.WithTimeout(p.Auth, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, response, err := p.Client.SystemAPI.GetSystemStatus(auth).Execute()
+		if err == nil && status.GetVersion() != "" {
+			tflog.Info(ctx, "prowlarr is ready, version: "+status.GetVersion())
+
+			return nil
+		}
+
+		// A wrong API key will not be fixed by waiting.
+		if response != nil && response.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("%w: %w", errStartupUnauthorized, err)
+		}
+
+		tflog.Info(ctx, "waiting for prowlarr to be ready")
+
+		select {
+		case <-auth.Done():
+			if err == nil {
+				return fmt.Errorf("%w within %s", errStartupTimeout, timeout)
+			}
+
+			return fmt.Errorf("%w within %s, last error: %w", errStartupTimeout, timeout, err)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkURLBase verifies that the instance is reachable under the given URL base and that it matches its configuration.
+// Other errors (e.g. authentication) are left to be reported by resources and data sources.
+func (p *ProwlarrData) checkURLBase(urlBase string) error {
+	status, response, err := p.Client.SystemAPI.GetSystemStatus(p.Auth).Execute()
+	if err != nil {
+		if helpers.IsNotFoundError(response, err) {
+			return fmt.Errorf("%w: Prowlarr API not found", errURLBase)
+		}
+
+		return nil
+	}
+
+	if strings.Trim(status.GetUrlBase(), "/") != strings.Trim(urlBase, "/") {
+		return fmt.Errorf("%w: instance is configured with '%s'", errURLBase, status.GetUrlBase())
+	}
+
+	return nil
+}
+
 // ResourceConfigure is a helper function to set the client for a specific resource.
 func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *prowlarr.APIClient) {
 	// Prevent panic if the provider has not been configured.
@@ -328,3 +635,245 @@ func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, res
 
 	return providerData.Auth, providerData.Client
 }
+
+// getDefaultTags returns the default tags from `default_tags` or `PROWLARR_DEFAULT_TAGS`.
+func getDefaultTags(ctx context.Context, data Prowlarr, diags *diag.Diagnostics) []string {
+	var tags []string
+
+	if !data.DefaultTags.IsNull() {
+		diags.Append(data.DefaultTags.ElementsAs(ctx, &tags, false)...)
+
+		return tags
+	}
+
+	for _, tag := range strings.Split(os.Getenv("PROWLARR_DEFAULT_TAGS"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// getAPIKey returns the API key from the first available source among
+// `api_key`, `PROWLARR_API_KEY`, `api_key_file` and `config_xml_path`.
+func getAPIKey(ctx context.Context, data Prowlarr, diags *diag.Diagnostics) string {
+	if key := getString(data.APIKey, "PROWLARR_API_KEY"); key != "" {
+		return key
+	}
+
+	sources := []string{"`api_key` attribute", "`PROWLARR_API_KEY` environment variable"}
+
+	if file := getString(data.APIKeyFile, "PROWLARR_API_KEY_FILE"); file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			diags.AddAttributeError(path.Root("api_key_file"), "Unable to find API key", fmt.Sprintf("Unable to read API key file %s, got error: %s", file, err))
+
+			return ""
+		}
+
+		if key := strings.TrimSpace(string(content)); key != "" {
+			tflog.Debug(ctx, "using API key from file "+file)
+
+			return key
+		}
+
+		sources = append(sources, "`api_key_file` "+file)
+	}
+
+	if file := getString(data.ConfigXMLPath, "PROWLARR_CONFIG_XML_PATH"); file != "" {
+		key, err := readConfigXMLAPIKey(file)
+		if err != nil {
+			diags.AddAttributeError(path.Root("config_xml_path"), "Unable to find API key", fmt.Sprintf("Unable to read API key from %s, got error: %s", file, err))
+
+			return ""
+		}
+
+		if key != "" {
+			tflog.Debug(ctx, "using API key from config.xml "+file)
+
+			return key
+		}
+
+		sources = append(sources, "`config_xml_path` "+file)
+	}
+
+	diags.AddError(
+		"Unable to find API key",
+		"API key cannot be an empty string. Sources tried: "+strings.Join(sources, ", "),
+	)
+
+	return ""
+}
+
+// readConfigXMLAPIKey reads the API key from the Prowlarr config.xml file.
+func readConfigXMLAPIKey(file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	var config struct {
+		APIKey string `xml:"ApiKey"`
+	}
+
+	if err := xml.Unmarshal(content, &config); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(config.APIKey), nil
+}
+
+// getClientOptions returns the HTTP client options from the provider configuration and the environment variables.
+func getClientOptions(ctx context.Context, data Prowlarr, diags *diag.Diagnostics) helpers.ClientOptions {
+	options := helpers.ClientOptions{
+		MaxRetries:          int(getInt64(data.MaxRetries, "PROWLARR_MAX_RETRIES", defaultMaxRetries, path.Root("max_retries"), diags)),
+		RetryWaitMin:        getDuration(data.RetryWaitMin, "PROWLARR_RETRY_WAIT_MIN", defaultRetryWaitMin, path.Root("retry_wait_min"), diags),
+		RetryWaitMax:        getDuration(data.RetryWaitMax, "PROWLARR_RETRY_WAIT_MAX", defaultRetryWaitMax, path.Root("retry_wait_max"), diags),
+		RequestTimeout:      getDuration(data.RequestTimeout, "PROWLARR_REQUEST_TIMEOUT", defaultRequestTimeout, path.Root("request_timeout"), diags),
+		ReadOnly:            getBool(data.ReadOnly, "PROWLARR_READ_ONLY", path.Root("read_only"), diags),
+		MaxParallelRequests: int(getInt64(data.MaxParallelRequests, "PROWLARR_MAX_PARALLEL_REQUESTS", 0, path.Root("max_parallel_requests"), diags)),
+		SerializeWrites:     getBool(data.SerializeWrites, "PROWLARR_SERIALIZE_WRITES", path.Root("serialize_writes"), diags),
+	}
+
+	if options.RetryWaitMin > options.RetryWaitMax {
+		diags.AddAttributeError(path.Root("retry_wait_min"), "Invalid Provider Configuration",
+			fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s).", options.RetryWaitMin, options.RetryWaitMax))
+	}
+
+	// TLS
+	caCert := []byte(getString(data.CACertPEM, "PROWLARR_CA_CERT_PEM"))
+	if file := getString(data.CACertFile, "PROWLARR_CA_CERT_FILE"); file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Invalid Provider Configuration", fmt.Sprintf("Unable to read CA certificate file, got error: %s", err))
+		}
+
+		caCert = content
+	}
+
+	tlsConfig, err := helpers.NewTLSConfig(helpers.TLSOptions{
+		CACert:             caCert,
+		ClientCert:         []byte(getString(data.ClientCert, "PROWLARR_CLIENT_CERT")),
+		ClientKey:          []byte(getString(data.ClientKey, "PROWLARR_CLIENT_KEY")),
+		InsecureSkipVerify: getBool(data.InsecureSkipVerify, "PROWLARR_INSECURE_SKIP_VERIFY", path.Root("insecure_skip_verify"), diags),
+	})
+	if err != nil {
+		diags.AddError("Invalid Provider Configuration", fmt.Sprintf("Unable to configure TLS, got error: %s", err))
+	}
+
+	options.TLSConfig = tlsConfig
+
+	// HTTP proxy
+	if proxy := getString(data.HTTPProxy, "PROWLARR_HTTP_PROXY"); proxy != "" {
+		proxyURL, err := url.Parse(proxy)
+		if err != nil || proxyURL.Host == "" {
+			diags.AddAttributeError(path.Root("http_proxy"), "Invalid Provider Configuration", fmt.Sprintf("Expected a valid proxy URL, got: %s", proxy))
+		}
+
+		options.Proxy = proxyURL
+	}
+
+	// Basic authentication
+	var basicAuth BasicAuth
+
+	if !data.BasicAuth.IsNull() {
+		diags.Append(data.BasicAuth.As(ctx, &basicAuth, basetypes.ObjectAsOptions{})...)
+	}
+
+	options.Username = getString(basicAuth.Username, "PROWLARR_BASIC_AUTH_USERNAME")
+	options.Password = getString(basicAuth.Password, "PROWLARR_BASIC_AUTH_PASSWORD")
+
+	return options
+}
+
+// getString returns the attribute value, falling back to the environment variable when null or empty.
+func getString(value types.String, env string) string {
+	if value.ValueString() != "" {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// getBool returns the attribute value, falling back to the environment variable and then to false.
+func getBool(value types.Bool, env string, attrPath path.Path, diags *diag.Diagnostics) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return false
+	}
+
+	result, err := strconv.ParseBool(envValue)
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid Provider Configuration", fmt.Sprintf("%s must be a boolean, got: %s", env, envValue))
+	}
+
+	return result
+}
+
+// getInt64 returns the attribute value, falling back to the environment variable and then to the default value.
+func getInt64(value types.Int64, env string, defaultValue int64, attrPath path.Path, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() {
+		return value.ValueInt64()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return defaultValue
+	}
+
+	result, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || result < 0 {
+		diags.AddAttributeError(attrPath, "Invalid Provider Configuration", fmt.Sprintf("%s must be a non negative integer, got: %s", env, envValue))
+	}
+
+	return result
+}
+
+// getStartupWait returns the startup wait timeout and interval, which must be positive.
+func getStartupWait(ctx context.Context, value types.Object, diags *diag.Diagnostics) (time.Duration, time.Duration) {
+	var wait StartupWait
+
+	diags.Append(value.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+	timeout := getPositiveDuration(wait.Timeout, defaultStartupTimeout, path.Root("startup_wait").AtName("timeout"), diags)
+	interval := getPositiveDuration(wait.Interval, defaultStartupPoll, path.Root("startup_wait").AtName("interval"), diags)
+
+	return timeout, interval
+}
+
+// getPositiveDuration returns the attribute duration or the default value, rejecting zero durations.
+func getPositiveDuration(value types.String, defaultValue time.Duration, attrPath path.Path, diags *diag.Diagnostics) time.Duration {
+	var localDiags diag.Diagnostics
+
+	duration := getDuration(value, "", defaultValue, attrPath, &localDiags)
+	if !localDiags.HasError() && duration == 0 {
+		localDiags.AddAttributeError(attrPath, "Invalid Provider Configuration", "Expected a positive duration (e.g. `5s`), got: "+value.ValueString())
+	}
+
+	diags.Append(localDiags...)
+
+	return duration
+}
+
+// getDuration returns the attribute duration, falling back to the environment variable and then to the default value.
+func getDuration(value types.String, env string, defaultValue time.Duration, attrPath path.Path, diags *diag.Diagnostics) time.Duration {
+	stringValue := value.ValueString()
+	if stringValue == "" {
+		stringValue = os.Getenv(env)
+	}
+
+	if stringValue == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(stringValue)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attrPath, "Invalid Provider Configuration", fmt.Sprintf("Expected a non negative duration (e.g. `30s`), got: %s", stringValue))
+	}
+
+	return duration
+}
@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -40,3 +51,307 @@ provider "prowlarr" {
 	]
   }
 `
+
+func TestCheckURLBase(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prowlarr/api/v1/system/status" && r.URL.Path != "/other/api/v1/system/status" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"1.0.0","urlBase":"/prowlarr"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		path  string
+		valid bool
+	}{
+		"matching": {
+			path:  "/prowlarr",
+			valid: true,
+		},
+		"trailing slash": {
+			path:  "/prowlarr/",
+			valid: true,
+		},
+		"mismatch": {
+			path:  "/other",
+			valid: false,
+		},
+		"not found": {
+			path:  "/missing",
+			valid: false,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiURL, err := url.Parse(server.URL + test.path)
+			assert.NoError(t, err)
+
+			data := ProwlarrData{
+				Auth:   newAuthContext(apiURL, "key"),
+				Client: prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+			}
+
+			err = data.checkURLBase(apiURL.Path)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errURLBase)
+			}
+		})
+	}
+}
+
+func TestGetAPIKey(t *testing.T) {
+	// Empty attributes fall back to the environment.
+	t.Setenv("PROWLARR_API_KEY", "")
+	t.Setenv("PROWLARR_API_KEY_FILE", "")
+	t.Setenv("PROWLARR_CONFIG_XML_PATH", "")
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key")
+	configXML := filepath.Join(dir, "config.xml")
+
+	assert.NoError(t, os.WriteFile(keyFile, []byte("fileKey\n"), 0o600))
+	assert.NoError(t, os.WriteFile(configXML, []byte("<Config>\n  <Port>9696</Port>\n  <ApiKey>xmlKey</ApiKey>\n</Config>"), 0o600))
+
+	tests := map[string]struct {
+		data     Prowlarr
+		expected string
+		err      bool
+	}{
+		"attribute": {
+			data:     Prowlarr{APIKey: types.StringValue("key"), APIKeyFile: types.StringValue(keyFile)},
+			expected: "key",
+		},
+		"file": {
+			data:     Prowlarr{APIKey: types.StringValue(""), APIKeyFile: types.StringValue(keyFile), ConfigXMLPath: types.StringValue(configXML)},
+			expected: "fileKey",
+		},
+		"config xml": {
+			data:     Prowlarr{APIKey: types.StringValue(""), APIKeyFile: types.StringValue(""), ConfigXMLPath: types.StringValue(configXML)},
+			expected: "xmlKey",
+		},
+		"missing file": {
+			data: Prowlarr{APIKey: types.StringValue(""), APIKeyFile: types.StringValue(filepath.Join(dir, "missing"))},
+			err:  true,
+		},
+		"invalid config xml": {
+			data: Prowlarr{APIKey: types.StringValue(""), APIKeyFile: types.StringValue(""), ConfigXMLPath: types.StringValue(keyFile)},
+			err:  true,
+		},
+		"none": {
+			data: Prowlarr{APIKey: types.StringValue(""), APIKeyFile: types.StringValue(""), ConfigXMLPath: types.StringValue("")},
+			err:  true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			key := getAPIKey(context.Background(), test.data, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+			assert.Equal(t, test.expected, key)
+		})
+	}
+}
+
+func TestGetAPIKeyEnv(t *testing.T) {
+	t.Setenv("PROWLARR_API_KEY", "envKey")
+
+	for name, value := range map[string]types.String{
+		"null":  types.StringNull(),
+		"empty": types.StringValue(""),
+	} {
+		var diags diag.Diagnostics
+
+		assert.Equal(t, "envKey", getAPIKey(context.Background(), Prowlarr{APIKey: value}, &diags), name)
+		assert.False(t, diags.HasError(), name)
+	}
+}
+
+func TestGetStartupWait(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		timeout  types.String
+		interval types.String
+		expected time.Duration
+		err      bool
+	}{
+		"defaults": {
+			timeout:  types.StringNull(),
+			interval: types.StringNull(),
+			expected: defaultStartupPoll,
+		},
+		"custom": {
+			timeout:  types.StringValue("1m"),
+			interval: types.StringValue("1s"),
+			expected: time.Second,
+		},
+		"zero interval": {
+			timeout:  types.StringNull(),
+			interval: types.StringValue("0s"),
+			err:      true,
+		},
+		"zero timeout": {
+			timeout:  types.StringValue("0"),
+			interval: types.StringNull(),
+			err:      true,
+		},
+		"negative interval": {
+			timeout:  types.StringNull(),
+			interval: types.StringValue("-1s"),
+			err:      true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			value := types.ObjectValueMust(
+				map[string]attr.Type{"timeout": types.StringType, "interval": types.StringType},
+				map[string]attr.Value{"timeout": test.timeout, "interval": test.interval},
+			)
+
+			_, interval := getStartupWait(context.Background(), value, &diags)
+			if test.err {
+				assert.Len(t, diags.Errors(), 1)
+
+				return
+			}
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.expected, interval)
+		})
+	}
+}
+
+func TestGetClientOptionsRetryWait(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		waitMin types.String
+		waitMax types.String
+		err     bool
+	}{
+		"defaults": {
+			waitMin: types.StringNull(),
+			waitMax: types.StringNull(),
+		},
+		"equal": {
+			waitMin: types.StringValue("5s"),
+			waitMax: types.StringValue("5s"),
+		},
+		"min greater than max": {
+			waitMin: types.StringValue("10s"),
+			waitMax: types.StringValue("1s"),
+			err:     true,
+		},
+		"min greater than default max": {
+			waitMin: types.StringValue("1m"),
+			waitMax: types.StringNull(),
+			err:     true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			getClientOptions(context.Background(), Prowlarr{RetryWaitMin: test.waitMin, RetryWaitMax: test.waitMax}, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+		})
+	}
+}
+
+func TestWaitForStartup(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status int
+		err    error
+	}{
+		"ready": {
+			status: http.StatusOK,
+		},
+		"unauthorized": {
+			status: http.StatusUnauthorized,
+			err:    errStartupUnauthorized,
+		},
+		"timeout": {
+			status: http.StatusServiceUnavailable,
+			err:    errStartupTimeout,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(`{"version":"1.0.0"}`))
+			}))
+			t.Cleanup(server.Close)
+
+			apiURL, err := url.Parse(server.URL)
+			assert.NoError(t, err)
+
+			data := ProwlarrData{
+				Auth:   newAuthContext(apiURL, "key"),
+				Client: prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+			}
+
+			err = data.waitForStartup(context.Background(), 100*time.Millisecond, 10*time.Millisecond)
+			if test.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+
+	t.Run("cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		apiURL, err := url.Parse("http://127.0.0.1:1")
+		assert.NoError(t, err)
+
+		data := ProwlarrData{
+			Auth:   newAuthContext(apiURL, "key"),
+			Client: prowlarr.NewAPIClient(prowlarr.NewConfiguration()),
+		}
+
+		assert.ErrorIs(t, data.waitForStartup(ctx, time.Minute, time.Second), context.Canceled)
+	})
+}
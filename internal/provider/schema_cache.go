@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultSchemaCacheTTL = 24 * time.Hour
+
+var (
+	errSchemaCacheExpired = errors.New("schema cache expired")
+	schemaCacheUnsafe     = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+)
+
+// SchemaCache stores the indexer schema list in memory, so that it is downloaded at most once per run.
+// With a directory it is also stored on disk, keyed by Prowlarr instance and version,
+// so that it is downloaded again only when the TTL expires or Prowlarr is upgraded.
+type SchemaCache struct {
+	schemas  []prowlarr.IndexerResource
+	dir      string
+	instance string
+	ttl      time.Duration
+	mu       sync.Mutex
+}
+
+// NewSchemaCache returns a schema cache in the given directory, in memory only if the directory is empty.
+func NewSchemaCache(dir, instance string, ttl time.Duration) *SchemaCache {
+	return &SchemaCache{
+		dir:      dir,
+		instance: instance,
+		ttl:      ttl,
+	}
+}
+
+// list returns the indexer schema list, from memory or from the disk cache if enabled and still valid.
+// Cache read and write failures are only logged, falling back to the API.
+func (c *SchemaCache) list(ctx context.Context, auth context.Context, client *prowlarr.APIClient) ([]prowlarr.IndexerResource, error) {
+	if c == nil {
+		response, _, err := client.IndexerAPI.ListIndexerSchema(auth).Execute()
+
+		return response, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.schemas != nil {
+		return c.schemas, nil
+	}
+
+	if c.dir == "" {
+		schemas, _, err := client.IndexerAPI.ListIndexerSchema(auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		c.schemas = schemas
+
+		return c.schemas, nil
+	}
+
+	status, _, err := client.SystemAPI.GetSystemStatus(auth).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	file := filepath.Join(c.dir, c.fileName(status.GetVersion()))
+
+	schemas, err := readSchemaCache(file, c.ttl)
+	if err == nil {
+		tflog.Debug(ctx, "indexer schema read from cache "+file)
+
+		c.schemas = schemas
+
+		return c.schemas, nil
+	}
+
+	if !errors.Is(err, os.ErrNotExist) && !errors.Is(err, errSchemaCacheExpired) {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to read indexer schema cache, got error: %s", err))
+	}
+
+	schemas, _, err = client.IndexerAPI.ListIndexerSchema(auth).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if writeErr := writeSchemaCache(file, schemas); writeErr != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to write indexer schema cache, got error: %s", writeErr))
+	}
+
+	c.schemas = schemas
+
+	return c.schemas, nil
+}
+
+// fileName identifies the cache file by instance, since custom definitions can differ, and version.
+func (c *SchemaCache) fileName(version string) string {
+	return fmt.Sprintf("indexer_schema_%x_%s.json", sha256.Sum256([]byte(c.instance)), schemaCacheUnsafe.ReplaceAllString(version, "_"))
+}
+
+func readSchemaCache(file string, ttl time.Duration) ([]prowlarr.IndexerResource, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Since(info.ModTime()) > ttl {
+		return nil, errSchemaCacheExpired
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var schemas []prowlarr.IndexerResource
+	if err := json.Unmarshal(content, &schemas); err != nil {
+		return nil, err
+	}
+
+	return schemas, nil
+}
+
+// writeSchemaCache writes the file atomically, so that concurrent runs never read a partial file.
+func writeSchemaCache(file string, schemas []prowlarr.IndexerResource) error {
+	content, err := json.Marshal(schemas)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(temp.Name())
+
+	if _, err = temp.Write(content); err != nil {
+		temp.Close()
+
+		return err
+	}
+
+	if err = temp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), file)
+}
+
+// resourceSchemaCache returns the provider schema cache for a specific resource.
+func resourceSchemaCache(req resource.ConfigureRequest) *SchemaCache {
+	if providerData, ok := req.ProviderData.(*ProwlarrData); ok {
+		return providerData.SchemaCache
+	}
+
+	return nil
+}
+
+// dataSourceSchemaCache returns the provider schema cache for a specific data source.
+func dataSourceSchemaCache(req datasource.ConfigureRequest) *SchemaCache {
+	if providerData, ok := req.ProviderData.(*ProwlarrData); ok {
+		return providerData.SchemaCache
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/prowlarr-go/prowlarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls   atomic.Int32
+		version atomic.Pointer[string]
+	)
+
+	initial, upgraded := "1.0.0", "1.1.0"
+	version.Store(&initial)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/system/status":
+			_, _ = w.Write([]byte(`{"version":"` + *version.Load() + `"}`))
+		case "/api/v1/indexer/schema":
+			calls.Add(1)
+			_, _ = w.Write([]byte(`[{"name":"Torznab","implementation":"Torznab","configContract":"TorznabSettings","fields":[{"name":"baseUrl","value":""}]}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	apiURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	auth := newAuthContext(apiURL, "key")
+	client := prowlarr.NewAPIClient(prowlarr.NewConfiguration())
+	dir := t.TempDir()
+
+	// Each cache represents a provider run.
+	run := func(ttl time.Duration) []prowlarr.IndexerResource {
+		schemas, err := NewSchemaCache(dir, apiURL.Host, ttl).list(ctx, auth, client)
+		assert.NoError(t, err)
+
+		return schemas
+	}
+
+	schemas := run(time.Hour)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "Torznab", schemas[0].GetName())
+	assert.Equal(t, "baseUrl", schemas[0].Fields[0].GetName())
+
+	// served from disk
+	schemas = run(time.Hour)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "TorznabSettings", schemas[0].GetConfigContract())
+
+	// expired
+	run(0)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// upgraded
+	version.Store(&upgraded)
+	run(time.Hour)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// memory only, downloaded once per run
+	cache := NewSchemaCache("", apiURL.Host, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		schemas, err = cache.list(ctx, auth, client)
+		assert.NoError(t, err)
+		assert.Equal(t, "Torznab", schemas[0].GetName())
+	}
+
+	assert.Equal(t, int32(4), calls.Load())
+
+	// not configured
+	_, err = (*SchemaCache)(nil).list(ctx, auth, client)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), calls.Load())
+}
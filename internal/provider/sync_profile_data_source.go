@@ -25,6 +25,7 @@ func NewSyncProfileDataSource() datasource.DataSource {
 type SyncProfileDataSource struct {
 	client *prowlarr.APIClient
 	auth   context.Context
+	cache  *ListCache
 }
 
 func (d *SyncProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,6 +69,7 @@ func (d *SyncProfileDataSource) Configure(ctx context.Context, req datasource.Co
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
 		d.auth = auth
+		d.cache = dataSourceListCache(req)
 	}
 }
 
@@ -80,7 +82,7 @@ func (d *SyncProfileDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 	// Get syncProfile current value
-	response, _, err := d.client.AppProfileAPI.ListAppProfile(d.auth).Execute()
+	response, _, err := cachedList(d.cache, syncProfileListPath, d.client.AppProfileAPI.ListAppProfile(d.auth).Execute)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, syncProfileDataSourceName, err))
 
@@ -118,7 +118,7 @@ func (r *SyncProfileResource) Create(ctx context.Context, req resource.CreateReq
 
 	response, _, err := r.client.AppProfileAPI.CreateAppProfile(r.auth).AppProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, syncProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, syncProfileResourceName, err)
 
 		return
 	}
@@ -140,9 +140,9 @@ func (r *SyncProfileResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get sync profile current value
-	response, _, err := r.client.AppProfileAPI.GetAppProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.AppProfileAPI.GetAppProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, syncProfileResourceName, err))
+		helpers.HandleReadError(ctx, syncProfileResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -168,7 +168,7 @@ func (r *SyncProfileResource) Update(ctx context.Context, req resource.UpdateReq
 
 	response, _, err := r.client.AppProfileAPI.UpdateAppProfile(r.auth, fmt.Sprint(request.GetId())).AppProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, syncProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, syncProfileResourceName, err)
 
 		return
 	}
@@ -201,10 +201,25 @@ func (r *SyncProfileResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *SyncProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, syncProfileResourceName, path.Root("id"), req, resp, syncProfileImportLookup(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+syncProfileResourceName+": "+req.ID)
 }
 
+// syncProfileImportLookup resolves sync profiles by `name:<name>` on import.
+func syncProfileImportLookup(auth context.Context, client *prowlarr.APIClient) helpers.ImportLookup[prowlarr.AppProfileResource] {
+	return helpers.ImportLookup[prowlarr.AppProfileResource]{
+		Keys: map[string]func(*prowlarr.AppProfileResource) string{
+			"name": (*prowlarr.AppProfileResource).GetName,
+		},
+		List: func() ([]prowlarr.AppProfileResource, error) {
+			response, _, err := client.AppProfileAPI.ListAppProfile(auth).Execute()
+
+			return response, err
+		},
+		ID: (*prowlarr.AppProfileResource).GetId,
+	}
+}
+
 func (s *SyncProfile) read() *prowlarr.AppProfileResource {
 	profile := *prowlarr.NewAppProfileResource()
 	profile.SetName(s.Name.ValueString())
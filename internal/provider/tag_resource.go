@@ -104,7 +104,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Create, tagResourceName, err)
 
 		return
 	}
@@ -126,9 +126,9 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get tag current value
-	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
+		helpers.HandleReadError(ctx, tagResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -156,7 +156,7 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	response, _, err := r.client.TagAPI.UpdateTag(r.auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, resp.State, helpers.Update, tagResourceName, err)
 
 		return
 	}
@@ -189,10 +189,25 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, tagResourceName, path.Root("id"), req, resp, tagImportLookup(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
+// tagImportLookup resolves tags by `label:<label>` on import.
+func tagImportLookup(auth context.Context, client *prowlarr.APIClient) helpers.ImportLookup[prowlarr.TagResource] {
+	return helpers.ImportLookup[prowlarr.TagResource]{
+		Keys: map[string]func(*prowlarr.TagResource) string{
+			"label": (*prowlarr.TagResource).GetLabel,
+		},
+		List: func() ([]prowlarr.TagResource, error) {
+			response, _, err := client.TagAPI.ListTag(auth).Execute()
+
+			return response, err
+		},
+		ID: (*prowlarr.TagResource).GetId,
+	}
+}
+
 func (t *Tag) write(tag *prowlarr.TagResource) {
 	t.ID = types.Int64Value(int64(tag.GetId()))
 	t.Label = types.StringValue(tag.GetLabel())
@@ -46,6 +46,13 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by label testing
+			{
+				ResourceName:      "prowlarr_tag.test",
+				ImportState:       true,
+				ImportStateId:     "label:nzb",
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"flag"
+	"io"
 	"log"
+	"os"
 
 	"github.com/devopsarr/terraform-provider-prowlarr/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -24,6 +26,14 @@ import (
 var version = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -40,3 +50,31 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// generate writes the configuration of an existing Prowlarr instance,
+// including the import blocks for all its resources.
+func generate(args []string) error {
+	var apiURL, keyFile, configXML, out string
+
+	// The API key is not accepted as a flag, to keep it out of the process list and shell history.
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	flags.StringVar(&apiURL, "url", os.Getenv("PROWLARR_URL"), "Prowlarr URL, defaults to PROWLARR_URL")
+	flags.StringVar(&keyFile, "api-key-file", "", "file containing the Prowlarr API key, defaults to PROWLARR_API_KEY_FILE")
+	flags.StringVar(&configXML, "config-xml-path", "", "Prowlarr config.xml to read the API key from, defaults to PROWLARR_CONFIG_XML_PATH")
+	flags.StringVar(&out, "out", "", "output file, defaults to stdout")
+	_ = flags.Parse(args)
+
+	var w io.Writer = os.Stdout
+
+	if out != "" {
+		file, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	return provider.Generate(context.Background(), w, apiURL, keyFile, configXML)
+}